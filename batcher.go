@@ -0,0 +1,30 @@
+package option
+
+import "sync"
+
+// Batcher accumulates items between flushes. The zero value is ready to use
+// and a Batcher is safe for concurrent use.
+type Batcher[T any] struct {
+	mu    sync.Mutex
+	items []T
+}
+
+// Add appends an item to the current batch.
+func (b *Batcher[T]) Add(v T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.items = append(b.items, v)
+}
+
+// Flush returns Some with the items accumulated since the last flush and
+// resets the buffer, or None if nothing was added.
+func (b *Batcher[T]) Flush() Option[[]T] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.items) == 0 {
+		return None[[]T]()
+	}
+	items := b.items
+	b.items = nil
+	return Some(items)
+}
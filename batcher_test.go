@@ -0,0 +1,19 @@
+package option
+
+import "testing"
+
+func TestBatcher(t *testing.T) {
+	var b Batcher[int]
+	if got := b.Flush(); got.IsSome() {
+		t.Errorf("empty flush: got %v, want None", got)
+	}
+	b.Add(1)
+	b.Add(2)
+	batch := b.Flush()
+	if batch.IsNone() || len(batch.Unwrap()) != 2 {
+		t.Errorf("flush: got %v, want Some([1 2])", batch)
+	}
+	if got := b.Flush(); got.IsSome() {
+		t.Errorf("second flush: got %v, want None", got)
+	}
+}
@@ -0,0 +1,34 @@
+package option
+
+// Chainer wraps an Option for fluent, same-type chaining.
+type Chainer[T any] struct {
+	opt Option[T]
+}
+
+// Chain starts a fluent chain over o.
+func Chain[T any](o Option[T]) *Chainer[T] {
+	return &Chainer[T]{opt: o}
+}
+
+// Map applies f to the contained value (if present).
+func (c *Chainer[T]) Map(f func(T) T) *Chainer[T] {
+	c.opt = c.opt.MapSame(f)
+	return c
+}
+
+// Filter keeps the value only if it satisfies predicate.
+func (c *Chainer[T]) Filter(predicate func(T) bool) *Chainer[T] {
+	c.opt = c.opt.Filter(predicate)
+	return c
+}
+
+// Or replaces a None with opt.
+func (c *Chainer[T]) Or(opt Option[T]) *Chainer[T] {
+	c.opt = c.opt.Or(opt)
+	return c
+}
+
+// Done ends the chain and returns the resulting Option.
+func (c *Chainer[T]) Done() Option[T] {
+	return c.opt
+}
@@ -0,0 +1,21 @@
+package option
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChain(t *testing.T) {
+	double := func(x int) int { return x * 2 }
+	big := func(x int) bool { return x > 10 }
+
+	if got := Chain(Some(3)).Map(double).Filter(big).Or(Some(-1)).Done(); !reflect.DeepEqual(got, Some(-1)) {
+		t.Errorf("filtered out: got %v, want Some(-1)", got)
+	}
+	if got := Chain(Some(6)).Map(double).Filter(big).Or(Some(-1)).Done(); !reflect.DeepEqual(got, Some(12)) {
+		t.Errorf("kept: got %v, want Some(12)", got)
+	}
+	if got := Some(2).MapSame(double); !reflect.DeepEqual(got, Some(4)) {
+		t.Errorf("MapSame: got %v, want Some(4)", got)
+	}
+}
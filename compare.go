@@ -0,0 +1,112 @@
+package option
+
+import (
+	"cmp"
+	"reflect"
+	"strings"
+)
+
+// LessFunc returns a less function over Options where Some values are
+// ordered by cmp.Less and None orders after every Some. It is a strict weak
+// ordering, so it can back container/heap or sort.Slice directly; a heap
+// built on it pops None last, treating it as the lowest priority.
+func LessFunc[T cmp.Ordered]() func(Option[T], Option[T]) bool {
+	return func(a, b Option[T]) bool {
+		if b.IsNone() {
+			return a.IsSome()
+		}
+		return a.IsSome() && cmp.Less(*a.value, *b.value)
+	}
+}
+
+// CompareBy returns a three-way comparison over Options, suitable for
+// slices.SortFunc, that orders Some values with less. None sorts after every
+// Some when noneLast is true and before every Some otherwise.
+func CompareBy[T any](less func(T, T) bool, noneLast bool) func(Option[T], Option[T]) int {
+	noneOrder := -1
+	if noneLast {
+		noneOrder = 1
+	}
+	return func(a, b Option[T]) int {
+		switch {
+		case a.IsNone() && b.IsNone():
+			return 0
+		case a.IsNone():
+			return noneOrder
+		case b.IsNone():
+			return -noneOrder
+		case less(*a.value, *b.value):
+			return -1
+		case less(*b.value, *a.value):
+			return 1
+		}
+		return 0
+	}
+}
+
+// ClampOption clamps v to lo if lo is Some and to hi if hi is Some, leaving
+// an absent bound unenforced.
+func ClampOption[T cmp.Ordered](v T, lo, hi Option[T]) T {
+	if lo.IsSome() && v < *lo.value {
+		v = *lo.value
+	}
+	if hi.IsSome() && v > *hi.value {
+		v = *hi.value
+	}
+	return v
+}
+
+// EqualFold reports whether a and b are both None, or both Some with values
+// that are equal under strings.EqualFold.
+func EqualFold(a, b Option[string]) bool {
+	if a.IsNone() || b.IsNone() {
+		return a.IsNone() && b.IsNone()
+	}
+	return strings.EqualFold(*a.value, *b.value)
+}
+
+// Equal reports whether a and b are both None, or both Some with equal
+// values. It is a function rather than a method because it needs T to be
+// comparable.
+func Equal[T comparable](a, b Option[T]) bool {
+	if a.IsNone() || b.IsNone() {
+		return a.IsNone() && b.IsNone()
+	}
+	return *a.value == *b.value
+}
+
+// Max returns the largest value among the Some Options, or None if all of
+// them are None. Values are ordered by cmp.Less, so a NaN is smaller than
+// any other float regardless of argument order.
+func Max[T cmp.Ordered](opts ...Option[T]) Option[T] {
+	best := None[T]()
+	for _, o := range opts {
+		if o.IsSome() && (best.IsNone() || cmp.Less(*best.value, *o.value)) {
+			best = o
+		}
+	}
+	return best
+}
+
+// Min returns the smallest value among the Some Options, or None if all of
+// them are None. Values are ordered by cmp.Less, so a NaN is smaller than
+// any other float regardless of argument order.
+func Min[T cmp.Ordered](opts ...Option[T]) Option[T] {
+	best := None[T]()
+	for _, o := range opts {
+		if o.IsSome() && (best.IsNone() || cmp.Less(*o.value, *best.value)) {
+			best = o
+		}
+	}
+	return best
+}
+
+// DeepEqual reports whether a and b are both None, or both Some with values
+// that are equal under reflect.DeepEqual. It works for non-comparable types
+// such as slices and maps, but is slower than Equal because of reflection.
+func DeepEqual[T any](a, b Option[T]) bool {
+	if a.IsNone() || b.IsNone() {
+		return a.IsNone() && b.IsNone()
+	}
+	return reflect.DeepEqual(*a.value, *b.value)
+}
@@ -0,0 +1,174 @@
+package option
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+type optionHeap struct {
+	items []Option[int]
+	less  func(Option[int], Option[int]) bool
+}
+
+func (h *optionHeap) Len() int           { return len(h.items) }
+func (h *optionHeap) Less(i, j int) bool { return h.less(h.items[i], h.items[j]) }
+func (h *optionHeap) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *optionHeap) Push(v any)         { h.items = append(h.items, v.(Option[int])) }
+
+func (h *optionHeap) Pop() any {
+	v := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return v
+}
+
+func TestLessFuncHeap(t *testing.T) {
+	h := &optionHeap{less: LessFunc[int]()}
+	for _, o := range []Option[int]{Some(3), None[int](), Some(1), None[int](), Some(2)} {
+		heap.Push(h, o)
+	}
+	want := []Option[int]{Some(1), Some(2), Some(3), None[int](), None[int]()}
+	for i, w := range want {
+		if got := heap.Pop(h).(Option[int]); !reflect.DeepEqual(got, w) {
+			t.Errorf("pop %d: got %v, want %v", i, got, w)
+		}
+	}
+}
+
+func TestCompareByNonePlacement(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	tests := []struct {
+		noneLast bool
+		want     string
+	}{
+		{true, "[Some(1) Some(2) None]"},
+		{false, "[None Some(1) Some(2)]"},
+	}
+	for _, tt := range tests {
+		s := []Option[int]{Some(2), None[int](), Some(1)}
+		slices.SortFunc(s, CompareBy(less, tt.noneLast))
+		if got := fmt.Sprint(s); got != tt.want {
+			t.Errorf("noneLast=%v: got %s, want %s", tt.noneLast, got, tt.want)
+		}
+	}
+}
+
+func TestClampOption(t *testing.T) {
+	none := None[int]()
+	tests := []struct {
+		name   string
+		v      int
+		lo, hi Option[int]
+		want   int
+	}{
+		{"unbounded", 5, none, none, 5},
+		{"below lo", -1, Some(0), none, 0},
+		{"above hi", 9, none, Some(3), 3},
+		{"both, above", 9, Some(0), Some(3), 3},
+		{"both, below", -9, Some(0), Some(3), 0},
+		{"both, inside", 2, Some(0), Some(3), 2},
+	}
+	for _, tt := range tests {
+		if got := ClampOption(tt.v, tt.lo, tt.hi); got != tt.want {
+			t.Errorf("%s: got %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestEqualFold(t *testing.T) {
+	none := None[string]()
+	tests := []struct {
+		name string
+		a, b Option[string]
+		want bool
+	}{
+		{"case differs", Some("Foo"), Some("foo"), true},
+		{"values differ", Some("a"), Some("b"), false},
+		{"both none", none, none, true},
+		{"none and some", none, Some(""), false},
+		{"some and none", Some(""), none, false},
+	}
+	for _, tt := range tests {
+		if got := EqualFold(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	cases := []struct {
+		a, b Option[int]
+		want bool
+	}{
+		{Some(1), Some(1), true},
+		{Some(1), Some(2), false},
+		{Some(1), None[int](), false},
+		{None[int](), None[int](), true},
+		{NoneWith[int](errors.New("gone")), None[int](), true},
+	}
+	for _, c := range cases {
+		if got := Equal(c.a, c.b); got != c.want {
+			t.Errorf("Equal(%v, %v) = %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestMaxMin(t *testing.T) {
+	opts := []Option[int]{None[int](), Some(3), Some(-1), None[int](), Some(7)}
+	if got := Max(opts...); !Equal(got, Some(7)) {
+		t.Errorf("Max: got %v, want Some(7)", got)
+	}
+	if got := Min(opts...); !Equal(got, Some(-1)) {
+		t.Errorf("Min: got %v, want Some(-1)", got)
+	}
+	none := []Option[int]{None[int](), None[int]()}
+	if got := Max(none...); got.IsSome() {
+		t.Errorf("Max of all None: got %v, want None", got)
+	}
+	if got := Min(none...); got.IsSome() {
+		t.Errorf("Min of all None: got %v, want None", got)
+	}
+	if got := Max[int](); got.IsSome() {
+		t.Errorf("Max(): got %v, want None", got)
+	}
+}
+
+func TestDeepEqual(t *testing.T) {
+	if !DeepEqual(Some([]int{1, 2}), Some([]int{1, 2})) {
+		t.Error("equal slices reported unequal")
+	}
+	if DeepEqual(Some([]int{1, 2}), Some([]int{2, 1})) {
+		t.Error("different slices reported equal")
+	}
+	if !DeepEqual(Some(map[string]int{"a": 1}), Some(map[string]int{"a": 1})) {
+		t.Error("equal maps reported unequal")
+	}
+	if DeepEqual(Some(map[string]int{"a": 1}), Some(map[string]int{"a": 2})) {
+		t.Error("different maps reported equal")
+	}
+	if !DeepEqual(None[[]int](), None[[]int]()) {
+		t.Error("None and None reported unequal")
+	}
+	if DeepEqual(Some([]int{}), None[[]int]()) {
+		t.Error("Some and None reported equal")
+	}
+}
+
+func TestMaxMinNaN(t *testing.T) {
+	nan := math.NaN()
+	for _, opts := range [][]Option[float64]{
+		{Some(nan), Some(1.0)},
+		{Some(1.0), Some(nan)},
+	} {
+		if got := Max(opts...); !Equal(got, Some(1.0)) {
+			t.Errorf("Max(%v) = %v, want Some(1)", opts, got)
+		}
+		if got := Min(opts...); got.IsNone() || !math.IsNaN(got.Unwrap()) {
+			t.Errorf("Min(%v) = %v, want Some(NaN)", opts, got)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package option
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrChanClosed is the Reason of the None returned by TryRecv for a closed channel.
+var ErrChanClosed = errors.New("option: channel closed")
+
+// CollectConcurrent runs fns concurrently, at most limit at a time, and
+// returns their Options in the same order as fns. A limit <= 0 runs every
+// function at once. The first error cancels the context passed to the
+// remaining functions and is returned; if ctx is cancelled first, its error
+// is returned.
+func CollectConcurrent[T any](ctx context.Context, limit int, fns []func(context.Context) (Option[T], error)) ([]Option[T], error) {
+	if limit <= 0 {
+		limit = len(fns)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	results := make([]Option[T], len(fns))
+	sem := make(chan struct{}, limit)
+loop:
+	for i, fn := range fns {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			fail(ctx.Err())
+			break loop
+		}
+		// select picks randomly among ready cases, so the send above can
+		// win after a failure has already cancelled ctx.
+		if err := ctx.Err(); err != nil {
+			<-sem
+			fail(err)
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			opt, err := fn(ctx)
+			if err != nil {
+				fail(err)
+				return
+			}
+			results[i] = opt
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// TryRecv receives from ch without blocking. It returns Some if a value was
+// ready and None otherwise. If ch is closed, the None's Reason is ErrChanClosed.
+func TryRecv[T any](ch <-chan T) Option[T] {
+	select {
+	case v, ok := <-ch:
+		if !ok {
+			return NoneWith[T](ErrChanClosed)
+		}
+		return Some(v)
+	default:
+		return None[T]()
+	}
+}
+
+// MapChan applies Map with f to every Option received from in and sends the
+// results, in order, on the returned channel. None elements pass through as
+// None. The returned channel is closed once in is closed and drained.
+// The caller must drain the returned channel; otherwise the goroutine
+// blocks on send and leaks.
+func MapChan[T, U any](in <-chan Option[T], f func(T) U) <-chan Option[U] {
+	out := make(chan Option[U])
+	go func() {
+		defer close(out)
+		for opt := range in {
+			out <- Map(opt, f)
+		}
+	}()
+	return out
+}
@@ -0,0 +1,144 @@
+package option
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCollectConcurrentOrder(t *testing.T) {
+	var running, peak atomic.Int32
+	var fns []func(context.Context) (Option[int], error)
+	for i := range 10 {
+		fns = append(fns, func(context.Context) (Option[int], error) {
+			n := running.Add(1)
+			defer running.Add(-1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(time.Duration(10-i) * time.Millisecond)
+			if i%2 == 0 {
+				return None[int](), nil
+			}
+			return Some(i), nil
+		})
+	}
+
+	got, err := CollectConcurrent(context.Background(), 3, fns)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, o := range got {
+		want := None[int]()
+		if i%2 == 1 {
+			want = Some(i)
+		}
+		if !reflect.DeepEqual(o, want) {
+			t.Errorf("result %d: got %v, want %v", i, o, want)
+		}
+	}
+	if p := peak.Load(); p > 3 {
+		t.Errorf("ran %d functions at once, limit was 3", p)
+	}
+}
+
+func TestCollectConcurrentError(t *testing.T) {
+	boom := errors.New("boom")
+	fns := []func(context.Context) (Option[int], error){
+		func(ctx context.Context) (Option[int], error) {
+			<-ctx.Done()
+			return None[int](), nil
+		},
+		func(context.Context) (Option[int], error) {
+			return None[int](), boom
+		},
+	}
+	if _, err := CollectConcurrent(context.Background(), 0, fns); err != boom {
+		t.Errorf("got %v, want %v", err, boom)
+	}
+}
+
+func TestCollectConcurrentCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	fns := []func(context.Context) (Option[int], error){
+		func(ctx context.Context) (Option[int], error) {
+			cancel()
+			<-ctx.Done()
+			return Some(1), nil
+		},
+		func(context.Context) (Option[int], error) {
+			return Some(2), nil
+		},
+	}
+	if _, err := CollectConcurrent(ctx, 1, fns); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestTryRecv(t *testing.T) {
+	ch := make(chan int, 1)
+	if got := TryRecv(ch); got.IsSome() || got.Reason().IsSome() {
+		t.Errorf("empty: got %v with reason %v, want plain None", got, got.Reason())
+	}
+	ch <- 1
+	if got := TryRecv(ch); !reflect.DeepEqual(got, Some(1)) {
+		t.Errorf("ready: got %v, want Some(1)", got)
+	}
+	close(ch)
+	if got := TryRecv(ch); got.IsSome() || !reflect.DeepEqual(got.Reason(), Some(ErrChanClosed)) {
+		t.Errorf("closed: got %v with reason %v, want None with ErrChanClosed", got, got.Reason())
+	}
+}
+
+func TestMapChan(t *testing.T) {
+	in := make(chan Option[int], 4)
+	in <- Some(1)
+	in <- None[int]()
+	in <- Some(3)
+	in <- Some(4)
+	close(in)
+	var got []Option[string]
+	for o := range MapChan(in, strconv.Itoa) {
+		got = append(got, o)
+	}
+	want := []Option[string]{Some("1"), None[string](), Some("3"), Some("4")}
+	if len(got) != len(want) {
+		t.Fatalf("got %d results, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !Equal(got[i], want[i]) {
+			t.Errorf("result %d: got %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestCollectConcurrentNoLaunchAfterError(t *testing.T) {
+	boom := errors.New("boom")
+	for range 100 {
+		var launched atomic.Int32
+		fns := []func(context.Context) (Option[int], error){
+			func(context.Context) (Option[int], error) {
+				return None[int](), boom
+			},
+		}
+		for range 10 {
+			fns = append(fns, func(context.Context) (Option[int], error) {
+				launched.Add(1)
+				return Some(1), nil
+			})
+		}
+		if _, err := CollectConcurrent(context.Background(), 1, fns); err != boom {
+			t.Fatalf("got %v, want %v", err, boom)
+		}
+		if n := launched.Load(); n != 0 {
+			t.Fatalf("%d functions started after the first error", n)
+		}
+	}
+}
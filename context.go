@@ -0,0 +1,19 @@
+package option
+
+import "context"
+
+// FromContext returns Some with the value stored in ctx under key, or None
+// if the key is missing or its value is not a T.
+func FromContext[T any](ctx context.Context, key any) Option[T] {
+	v, ok := ctx.Value(key).(T)
+	if !ok {
+		return None[T]()
+	}
+	return Some(v)
+}
+
+// WithValue returns a copy of ctx that stores v under key, for retrieval
+// with FromContext.
+func WithValue[T any](ctx context.Context, key any, v T) context.Context {
+	return context.WithValue(ctx, key, v)
+}
@@ -0,0 +1,22 @@
+package option
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+type ctxKey struct{}
+
+func TestFromContext(t *testing.T) {
+	ctx := WithValue(context.Background(), ctxKey{}, 5)
+	if got := FromContext[int](ctx, ctxKey{}); !reflect.DeepEqual(got, Some(5)) {
+		t.Errorf("present: got %v, want Some(5)", got)
+	}
+	if got := FromContext[string](ctx, ctxKey{}); got.IsSome() {
+		t.Errorf("wrong type: got %v, want None", got)
+	}
+	if got := FromContext[int](context.Background(), ctxKey{}); got.IsSome() {
+		t.Errorf("absent: got %v, want None", got)
+	}
+}
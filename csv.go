@@ -0,0 +1,23 @@
+package option
+
+// MarshalCSVField formats o as a CSV cell: "" for None and format(value)
+// for Some.
+func MarshalCSVField[T any](o Option[T], format func(T) string) string {
+	if o.IsNone() {
+		return ""
+	}
+	return format(*o.value)
+}
+
+// UnmarshalCSVField parses a CSV cell, treating the empty string as None
+// and passing anything else to parse.
+func UnmarshalCSVField[T any](s string, parse func(string) (T, error)) (Option[T], error) {
+	if s == "" {
+		return None[T](), nil
+	}
+	v, err := parse(s)
+	if err != nil {
+		return None[T](), err
+	}
+	return Some(v), nil
+}
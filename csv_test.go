@@ -0,0 +1,25 @@
+package option
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestCSVFieldRoundTrip(t *testing.T) {
+	for _, o := range []Option[int]{Some(42), Some(0), None[int]()} {
+		cell := MarshalCSVField(o, strconv.Itoa)
+		got, err := UnmarshalCSVField(cell, strconv.Atoi)
+		if err != nil {
+			t.Fatalf("UnmarshalCSVField(%q): %v", cell, err)
+		}
+		if !Equal(got, o) {
+			t.Errorf("round trip of %v: got %v", o, got)
+		}
+	}
+	if cell := MarshalCSVField(None[int](), strconv.Itoa); cell != "" {
+		t.Errorf("None cell = %q, want empty", cell)
+	}
+	if _, err := UnmarshalCSVField("x", strconv.Atoi); err == nil {
+		t.Error("UnmarshalCSVField(\"x\") returned no error")
+	}
+}
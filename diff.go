@@ -0,0 +1,47 @@
+package option
+
+import "strconv"
+
+// Change classifies how an Option differs between two states.
+type Change int
+
+const (
+	// Unchanged means both Options are None, or both are Some with equal values.
+	Unchanged Change = iota
+	// Added means the Option went from None to Some.
+	Added
+	// Removed means the Option went from Some to None.
+	Removed
+	// Modified means the Option went from Some to a different Some.
+	Modified
+)
+
+// String returns the name of the Change.
+func (c Change) String() string {
+	switch c {
+	case Unchanged:
+		return "Unchanged"
+	case Added:
+		return "Added"
+	case Removed:
+		return "Removed"
+	case Modified:
+		return "Modified"
+	}
+	return "Change(" + strconv.Itoa(int(c)) + ")"
+}
+
+// Diff reports how prev changed into next.
+func Diff[T comparable](prev, next Option[T]) Change {
+	switch {
+	case prev.IsNone() && next.IsNone():
+		return Unchanged
+	case prev.IsNone():
+		return Added
+	case next.IsNone():
+		return Removed
+	case *prev.value == *next.value:
+		return Unchanged
+	}
+	return Modified
+}
@@ -0,0 +1,36 @@
+package option
+
+import "testing"
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name       string
+		prev, next Option[int]
+		want       Change
+	}{
+		{"both none", None[int](), None[int](), Unchanged},
+		{"equal some", Some(1), Some(1), Unchanged},
+		{"added", None[int](), Some(1), Added},
+		{"removed", Some(1), None[int](), Removed},
+		{"modified", Some(1), Some(2), Modified},
+	}
+	for _, tt := range tests {
+		if got := Diff(tt.prev, tt.next); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestChangeString(t *testing.T) {
+	for c, want := range map[Change]string{
+		Unchanged: "Unchanged",
+		Added:     "Added",
+		Removed:   "Removed",
+		Modified:  "Modified",
+		Change(9): "Change(9)",
+	} {
+		if got := c.String(); got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package option
+
+import (
+	"errors"
+	"path/filepath"
+	"runtime"
+	"strconv"
+)
+
+// ErrNone is matched by the error Unwrap panics with when called on a None.
+// The panic value wraps ErrNone rather than being ErrNone itself, so code
+// that recovers it must use errors.Is(err, ErrNone), not err == ErrNone.
+var ErrNone = errors.New("called `Unwrap()` on a `None` value")
+
+// unwrapNoneError is the panic value of Unwrap. It adds the call site to
+// ErrNone's message.
+type unwrapNoneError struct {
+	location string
+}
+
+func (e *unwrapNoneError) Error() string {
+	return ErrNone.Error() + " (at " + e.location + ")"
+}
+
+func (e *unwrapNoneError) Unwrap() error {
+	return ErrNone
+}
+
+// panicNone panics with ErrNone, annotated with the file:line that called
+// the function calling panicNone when it is known.
+func panicNone() {
+	_, file, line, ok := runtime.Caller(2)
+	if !ok {
+		panic(ErrNone)
+	}
+	panic(&unwrapNoneError{location: filepath.Base(file) + ":" + strconv.Itoa(line)})
+}
+
+// Guard runs f and converts a panic from unwrapping a None into an error
+// matching ErrNone. Any other panic is propagated unchanged.
+func Guard[T any](f func() T) (result T, err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		if e, ok := r.(error); ok && errors.Is(e, ErrNone) {
+			err = e
+			return
+		}
+		panic(r)
+	}()
+	return f(), nil
+}
+
+// JoinErrs joins the errors held by the Some Options with errors.Join.
+// It returns nil if none of the Options hold an error.
+func JoinErrs(opts ...Option[error]) error {
+	errs := make([]error, 0, len(opts))
+	for _, o := range opts {
+		if o.IsSome() {
+			errs = append(errs, *o.value)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ToError returns the contained error, or nil if o is None.
+func ToError(o Option[error]) error {
+	return o.UnwrapOr(nil)
+}
+
+// FromError returns Some(err), or None if err is nil.
+func FromError(err error) Option[error] {
+	return When(err != nil, err)
+}
+
+// AsError returns Some of the first error in the tree of the contained error
+// that matches E, as found by errors.As. It returns None if o is None or
+// nothing matches.
+func AsError[E error](o Option[error]) Option[E] {
+	var target E
+	if o.IsNone() || !errors.As(*o.value, &target) {
+		return None[E]()
+	}
+	return Some(target)
+}
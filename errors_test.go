@@ -0,0 +1,84 @@
+package option
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"testing"
+)
+
+func TestJoinErrs(t *testing.T) {
+	a, b := errors.New("a"), errors.New("b")
+	err := JoinErrs(Some(a), None[error](), Some(b))
+	if !errors.Is(err, a) || !errors.Is(err, b) || err.Error() != "a\nb" {
+		t.Errorf("got %v, want a and b joined", err)
+	}
+	if err := JoinErrs(None[error](), None[error]()); err != nil {
+		t.Errorf("all None: got %v, want nil", err)
+	}
+}
+
+func TestGuard(t *testing.T) {
+	v, err := Guard(func() int { return None[int]().Unwrap() })
+	if v != 0 || !errors.Is(err, ErrNone) {
+		t.Errorf("None unwrap: got (%v, %v), want (0, ErrNone)", v, err)
+	}
+	if v, err := Guard(func() int { return Some(2).Unwrap() }); v != 2 || err != nil {
+		t.Errorf("Some: got (%v, %v), want (2, nil)", v, err)
+	}
+
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("unrelated panic: recovered %v, want boom", r)
+		}
+	}()
+	Guard(func() int { panic("boom") })
+	t.Error("unrelated panic was swallowed")
+}
+
+func TestErrorConversions(t *testing.T) {
+	err := errors.New("boom")
+	if got := ToError(FromError(err)); got != err {
+		t.Errorf("ToError(FromError(err)) = %v, want %v", got, err)
+	}
+	if got := FromError(nil); got.IsSome() {
+		t.Errorf("FromError(nil) = %v, want None", got)
+	}
+	if got := ToError(None[error]()); got != nil {
+		t.Errorf("ToError(None) = %v, want nil", got)
+	}
+	if got := FromError(ToError(Some(err))); !Equal(got, Some(err)) {
+		t.Errorf("FromError(ToError(Some(err))) = %v, want Some(err)", got)
+	}
+}
+
+func TestAsError(t *testing.T) {
+	pathErr := &fs.PathError{Op: "open", Path: "x", Err: fs.ErrNotExist}
+	wrapped := Some[error](fmt.Errorf("loading: %w", pathErr))
+	if got := AsError[*fs.PathError](wrapped); !Equal(got, Some(pathErr)) {
+		t.Errorf("wrapped match: got %v, want Some(%v)", got, pathErr)
+	}
+	if got := AsError[*fs.PathError](Some(errors.New("other"))); got.IsSome() {
+		t.Errorf("non-matching error: got %v, want None", got)
+	}
+	if got := AsError[*fs.PathError](None[error]()); got.IsSome() {
+		t.Errorf("None: got %v, want None", got)
+	}
+}
+
+func TestUnwrapPanicValue(t *testing.T) {
+	defer func() {
+		err, ok := recover().(error)
+		if !ok {
+			t.Fatal("Unwrap on None did not panic with an error")
+		}
+		if !errors.Is(err, ErrNone) {
+			t.Errorf("panic value %v does not match ErrNone", err)
+		}
+		if !strings.Contains(err.Error(), "errors_test.go:") {
+			t.Errorf("panic message %q lacks the caller's file:line", err)
+		}
+	}()
+	None[int]().Unwrap()
+}
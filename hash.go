@@ -0,0 +1,15 @@
+package option
+
+import "hash"
+
+// WriteHash folds o into h. It writes 0x00 for None, or 0x01 followed by
+// whatever write adds for the value for Some, so None and Some of the zero
+// value always hash differently.
+func WriteHash[T any](o Option[T], h hash.Hash, write func(hash.Hash, T)) {
+	if o.IsNone() {
+		h.Write([]byte{0x00})
+		return
+	}
+	h.Write([]byte{0x01})
+	write(h, *o.value)
+}
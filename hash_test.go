@@ -0,0 +1,23 @@
+package option
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"hash"
+	"testing"
+)
+
+func TestWriteHash(t *testing.T) {
+	write := func(h hash.Hash, s string) { h.Write([]byte(s)) }
+	sum := func(o Option[string]) []byte {
+		h := sha256.New()
+		WriteHash(o, h, write)
+		return h.Sum(nil)
+	}
+	if bytes.Equal(sum(None[string]()), sum(Some(""))) {
+		t.Error("None and Some(\"\") hash the same")
+	}
+	if !bytes.Equal(sum(Some("a")), sum(Some("a"))) {
+		t.Error("equal values hash differently")
+	}
+}
@@ -0,0 +1,86 @@
+package option
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// MarshalJSON encodes Some as its inner value and None as null.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if o.IsNone() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(*o.value)
+}
+
+// UnmarshalJSON decodes null as None and any other value as Some.
+// Numbers are decoded with UseNumber, so large integers inside `any`,
+// map[string]any or []any values are kept as json.Number instead of losing
+// precision as float64.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+	var v T
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return errors.New("option: invalid data after top-level JSON value")
+	}
+	*o = Some(v)
+	return nil
+}
+
+// UnmarshalWithSentinel decodes data like UnmarshalJSON, and also maps a
+// decoded value equal to sentinel to None. It is meant for APIs that use a
+// magic value such as "NULL" or -1 to mean absent.
+func UnmarshalWithSentinel[T comparable](data []byte, sentinel T) (Option[T], error) {
+	var o Option[T]
+	if err := o.UnmarshalJSON(data); err != nil {
+		return None[T](), err
+	}
+	return o.Filter(func(v T) bool { return v != sentinel }), nil
+}
+
+// GetPath decodes data and walks nested objects by key, returning Some of
+// the value at path. It returns None if data is invalid, a key is missing,
+// a segment before the last is not an object, or the value is null.
+// Numbers are returned as json.Number.
+func GetPath(data []byte, path ...string) Option[any] {
+	var root Option[any]
+	if err := root.UnmarshalJSON(data); err != nil {
+		return None[any]()
+	}
+	cur := root
+	for _, key := range path {
+		obj, ok := cur.UnwrapOr(nil).(map[string]any)
+		if !ok {
+			return None[any]()
+		}
+		v, ok := obj[key]
+		if !ok || v == nil {
+			return None[any]()
+		}
+		cur = Some(v)
+	}
+	return cur
+}
+
+// DumpJSON renders opts as an indented JSON array, with None as null and
+// Some as its value. It is meant for debugging and snapshot tests.
+func DumpJSON[T any](opts []Option[T]) (string, error) {
+	if opts == nil {
+		opts = []Option[T]{}
+	}
+	b, err := json.MarshalIndent(opts, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,128 @@
+package option
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalJSONLargeNumber(t *testing.T) {
+	const digits = "1234567890123456789"
+
+	var n Option[json.Number]
+	if err := json.Unmarshal([]byte(digits), &n); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := n.Unwrap(); got.String() != digits {
+		t.Errorf("json.Number: got %s, want %s", got, digits)
+	}
+
+	var a Option[any]
+	if err := json.Unmarshal([]byte(digits), &a); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, ok := a.Unwrap().(json.Number); !ok || got.String() != digits {
+		t.Errorf("any: got %#v, want json.Number(%s)", a.Unwrap(), digits)
+	}
+
+	var m Option[map[string]any]
+	if err := json.Unmarshal([]byte(`{"id":`+digits+`}`), &m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, ok := m.Unwrap()["id"].(json.Number); !ok || got.String() != digits {
+		t.Errorf("map[string]any: got %#v, want json.Number(%s)", m.Unwrap()["id"], digits)
+	}
+}
+
+func TestUnmarshalJSONNull(t *testing.T) {
+	o := Some(1)
+	if err := json.Unmarshal([]byte("null"), &o); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if o.IsSome() {
+		t.Errorf("expected None, got %v", o)
+	}
+}
+
+func TestUnmarshalJSONTrailingData(t *testing.T) {
+	var i Option[int]
+	if err := i.UnmarshalJSON([]byte("1 garbage")); err == nil {
+		t.Errorf("Option[int]: expected error, got %v", i)
+	}
+	var a Option[any]
+	if err := a.UnmarshalJSON([]byte(`{"a":1} trailing`)); err == nil {
+		t.Errorf("Option[any]: expected error, got %v", a)
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	b, err := json.Marshal(struct{ A, B Option[int] }{Some(1), None[int]()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := `{"A":1,"B":null}`; string(b) != want {
+		t.Errorf("got %s, want %s", b, want)
+	}
+}
+
+func TestUnmarshalWithSentinel(t *testing.T) {
+	if got, err := UnmarshalWithSentinel([]byte(`"NULL"`), "NULL"); err != nil || got.IsSome() {
+		t.Errorf("string sentinel: got (%v, %v), want (None, nil)", got, err)
+	}
+	if got, err := UnmarshalWithSentinel([]byte(`"x"`), "NULL"); err != nil || !reflect.DeepEqual(got, Some("x")) {
+		t.Errorf("string value: got (%v, %v), want (Some(x), nil)", got, err)
+	}
+	if got, err := UnmarshalWithSentinel([]byte(`-1`), -1); err != nil || got.IsSome() {
+		t.Errorf("numeric sentinel: got (%v, %v), want (None, nil)", got, err)
+	}
+	if got, err := UnmarshalWithSentinel([]byte(`3`), -1); err != nil || !reflect.DeepEqual(got, Some(3)) {
+		t.Errorf("numeric value: got (%v, %v), want (Some(3), nil)", got, err)
+	}
+	if got, err := UnmarshalWithSentinel([]byte(`1 garbage`), -1); err == nil {
+		t.Errorf("trailing data: got %v, want error", got)
+	}
+}
+
+func TestGetPath(t *testing.T) {
+	data := []byte(`{"a":{"b":{"c":12345678901234567890},"s":"x"}}`)
+	if got := GetPath(data, "a", "b", "c"); got.IsNone() || got.Unwrap() != json.Number("12345678901234567890") {
+		t.Errorf("present: got %v, want Some(12345678901234567890)", got)
+	}
+	if got := GetPath(data, "a", "missing"); got.IsSome() {
+		t.Errorf("missing key: got %v, want None", got)
+	}
+	if got := GetPath(data, "a", "s", "deeper"); got.IsSome() {
+		t.Errorf("non-object: got %v, want None", got)
+	}
+	if got := GetPath([]byte(`{"a":1} trailing`), "a"); got.IsSome() {
+		t.Errorf("trailing data: got %v, want None", got)
+	}
+}
+
+func TestDumpJSON(t *testing.T) {
+	got, err := DumpJSON([]Option[int]{Some(1), None[int](), Some(3)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "[\n  1,\n  null,\n  3\n]"
+	if got != want {
+		t.Errorf("DumpJSON = %q, want %q", got, want)
+	}
+	if !json.Valid([]byte(got)) {
+		t.Errorf("DumpJSON produced invalid JSON: %s", got)
+	}
+	if got, _ := DumpJSON[int](nil); got != "[]" {
+		t.Errorf("DumpJSON(nil) = %q, want []", got)
+	}
+}
+
+func TestUnmarshalJSONInt64Precision(t *testing.T) {
+	const want int64 = 1234567890123456789
+	var o Option[int64]
+	if err := json.Unmarshal([]byte("1234567890123456789"), &o); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !Equal(o, Some(want)) {
+		t.Errorf("got %v, want Some(%d)", o, want)
+	}
+}
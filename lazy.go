@@ -0,0 +1,25 @@
+package option
+
+import "sync"
+
+// Lazy is an Option computed on first access. It is safe for concurrent use.
+type Lazy[T any] struct {
+	once sync.Once
+	f    func() Option[T]
+	opt  Option[T]
+}
+
+// NewLazy creates a Lazy that computes its Option by calling f.
+func NewLazy[T any](f func() Option[T]) *Lazy[T] {
+	return &Lazy[T]{f: f}
+}
+
+// Force returns the Option, calling the producer on the first call only.
+// The result is cached whether it is Some or None.
+func (l *Lazy[T]) Force() Option[T] {
+	l.once.Do(func() {
+		l.opt = l.f()
+		l.f = nil
+	})
+	return l.opt
+}
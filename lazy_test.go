@@ -0,0 +1,32 @@
+package option
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLazyForceOnce(t *testing.T) {
+	var calls atomic.Int32
+	l := NewLazy(func() Option[int] {
+		calls.Add(1)
+		return None[int]()
+	})
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			l.Force()
+		}()
+	}
+	wg.Wait()
+
+	if got := l.Force(); got.IsSome() {
+		t.Errorf("got %v, want cached None", got)
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("producer ran %d times, want 1", n)
+	}
+}
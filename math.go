@@ -0,0 +1,44 @@
+package option
+
+import "math"
+
+// Integer is the set of integer types.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is the set of floating-point types.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is the set of integer and floating-point types.
+type Number interface {
+	Integer | Float
+}
+
+// Div returns Some(a / b), or None if b is zero.
+func Div[T Number](a, b T) Option[T] {
+	if b == 0 {
+		return None[T]()
+	}
+	return Some(a / b)
+}
+
+// CheckedAdd returns Some(a + b), or None if the sum overflows T.
+func CheckedAdd[T Integer](a, b T) Option[T] {
+	sum := a + b
+	if (b > 0 && sum < a) || (b < 0 && sum > a) {
+		return None[T]()
+	}
+	return Some(sum)
+}
+
+// Sqrt returns Some(math.Sqrt(x)), or None if x is negative or NaN.
+func Sqrt(x float64) Option[float64] {
+	if x < 0 || math.IsNaN(x) {
+		return None[float64]()
+	}
+	return Some(math.Sqrt(x))
+}
@@ -0,0 +1,49 @@
+package option
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestDiv(t *testing.T) {
+	if got := Div(1, 0); got.IsSome() {
+		t.Errorf("zero divisor: got %v, want None", got)
+	}
+	if got := Div(7, 2); !reflect.DeepEqual(got, Some(3)) {
+		t.Errorf("int: got %v, want Some(3)", got)
+	}
+	if got := Div(1.0, 4); !reflect.DeepEqual(got, Some(0.25)) {
+		t.Errorf("float: got %v, want Some(0.25)", got)
+	}
+}
+
+func TestCheckedAdd(t *testing.T) {
+	if got := CheckedAdd[int8](127, 1); got.IsSome() {
+		t.Errorf("int8 overflow: got %v, want None", got)
+	}
+	if got := CheckedAdd[int8](-128, -1); got.IsSome() {
+		t.Errorf("int8 underflow: got %v, want None", got)
+	}
+	if got := CheckedAdd[uint8](255, 1); got.IsSome() {
+		t.Errorf("uint8 overflow: got %v, want None", got)
+	}
+	if got := CheckedAdd(math.MaxInt64-1, 1); !reflect.DeepEqual(got, Some(math.MaxInt64)) {
+		t.Errorf("no overflow: got %v, want Some(MaxInt64)", got)
+	}
+	if got := CheckedAdd(3, -5); !reflect.DeepEqual(got, Some(-2)) {
+		t.Errorf("negative: got %v, want Some(-2)", got)
+	}
+}
+
+func TestSqrt(t *testing.T) {
+	if got := Sqrt(4); !reflect.DeepEqual(got, Some(2.0)) {
+		t.Errorf("positive: got %v, want Some(2)", got)
+	}
+	if got := Sqrt(-1); got.IsSome() {
+		t.Errorf("negative: got %v, want None", got)
+	}
+	if got := Sqrt(math.NaN()); got.IsSome() {
+		t.Errorf("NaN: got %v, want None", got)
+	}
+}
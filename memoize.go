@@ -0,0 +1,28 @@
+package option
+
+import "sync"
+
+type memoEntry[V any] struct {
+	once sync.Once
+	opt  Option[V]
+}
+
+// Memoize returns a wrapper around f that caches its result per key,
+// including None results, so f runs at most once for each distinct key.
+// The wrapper is safe for concurrent use; concurrent calls for the same key
+// wait for the first one to finish.
+func Memoize[K comparable, V any](f func(K) Option[V]) func(K) Option[V] {
+	var mu sync.Mutex
+	cache := make(map[K]*memoEntry[V])
+	return func(k K) Option[V] {
+		mu.Lock()
+		e, ok := cache[k]
+		if !ok {
+			e = &memoEntry[V]{}
+			cache[k] = e
+		}
+		mu.Unlock()
+		e.once.Do(func() { e.opt = f(k) })
+		return e.opt
+	}
+}
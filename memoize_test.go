@@ -0,0 +1,42 @@
+package option
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestMemoize(t *testing.T) {
+	var calls atomic.Int32
+	lookup := Memoize(func(k int) Option[int] {
+		calls.Add(1)
+		if k%2 == 0 {
+			return None[int]()
+		}
+		return Some(k)
+	})
+
+	var wg sync.WaitGroup
+	for i := range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lookup(i % 4)
+		}()
+	}
+	wg.Wait()
+
+	if n := calls.Load(); n != 4 {
+		t.Errorf("f ran %d times, want once per key (4)", n)
+	}
+	if got := lookup(1); !reflect.DeepEqual(got, Some(1)) {
+		t.Errorf("cached Some: got %v, want Some(1)", got)
+	}
+	if got := lookup(2); got.IsSome() {
+		t.Errorf("cached None: got %v, want None", got)
+	}
+	if n := calls.Load(); n != 4 {
+		t.Errorf("cache miss on repeat lookups: f ran %d times", n)
+	}
+}
@@ -0,0 +1,32 @@
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// EncodeMsgpack encodes Some as its inner value and None as msgpack nil.
+func (o Option[T]) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if o.IsNone() {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(*o.value)
+}
+
+// DecodeMsgpack decodes msgpack nil as None and any other value as Some.
+func (o *Option[T]) DecodeMsgpack(dec *msgpack.Decoder) error {
+	code, err := dec.PeekCode()
+	if err != nil {
+		return err
+	}
+	if code == msgpcode.Nil {
+		*o = None[T]()
+		return dec.DecodeNil()
+	}
+	var v T
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
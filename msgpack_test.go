@@ -0,0 +1,25 @@
+package option
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func TestMsgpackRoundTrip(t *testing.T) {
+	type record struct {
+		A, B Option[int]
+	}
+	b, err := msgpack.Marshal(record{Some(42), None[int]()})
+	if err != nil {
+		t.Fatalf("unexpected marshal error: %v", err)
+	}
+	var got record
+	if err := msgpack.Unmarshal(b, &got); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	if !reflect.DeepEqual(got.A, Some(42)) || got.B.IsSome() {
+		t.Errorf("got %+v, want {A:Some(42) B:None}", got)
+	}
+}
@@ -0,0 +1,29 @@
+package option
+
+import "sync"
+
+// OnceOption starts as None and can be set exactly once. The zero value is
+// ready to use and an OnceOption is safe for concurrent use.
+type OnceOption[T any] struct {
+	mu  sync.RWMutex
+	opt Option[T]
+}
+
+// Set stores v if no value has been set yet and reports whether it did.
+// Later calls leave the stored value unchanged and return false.
+func (o *OnceOption[T]) Set(v T) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.opt.IsSome() {
+		return false
+	}
+	o.opt = Some(v)
+	return true
+}
+
+// Get returns the stored value, or None if Set has not been called.
+func (o *OnceOption[T]) Get() Option[T] {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.opt
+}
@@ -0,0 +1,38 @@
+package option
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestOnceOptionConcurrentSet(t *testing.T) {
+	var o OnceOption[int]
+	if got := o.Get(); got.IsSome() {
+		t.Fatalf("zero OnceOption: got %v, want None", got)
+	}
+	var wins atomic.Int32
+	winner := make(chan int, 1)
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if o.Set(i) {
+				wins.Add(1)
+				winner <- i
+			}
+			o.Get()
+		}()
+	}
+	wg.Wait()
+	if n := wins.Load(); n != 1 {
+		t.Fatalf("%d Set calls succeeded, want 1", n)
+	}
+	if got, want := o.Get(), Some(<-winner); !Equal(got, want) {
+		t.Errorf("Get() = %v, want %v", got, want)
+	}
+	if o.Set(-1) {
+		t.Error("Set after the first returned true")
+	}
+}
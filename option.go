@@ -3,11 +3,31 @@ package option
 import (
 	"errors"
 	"fmt"
+	"io"
+	"iter"
 )
 
+// NoneString is the text String returns for a None value.
+var NoneString = "None"
+
+// SomeFormat is the fmt template String uses to render a Some value.
+var SomeFormat = "Some(%v)"
+
 // Option represents an optional value that may or may not be present.
+//
+// Options are always comparable, but == also compares the reason recorded
+// by NoneWith, so NoneWith(err) != None(). Use Equal to compare by presence
+// and value only.
 type Option[T any] struct {
-	value *T
+	value  *T
+	reason *noneReason
+}
+
+// noneReason boxes the error recorded by NoneWith. Keeping it behind a
+// pointer leaves Option two words wide and comparable whatever the error's
+// dynamic type is.
+type noneReason struct {
+	err error
 }
 
 // Some creates an Option with a present value.
@@ -20,6 +40,47 @@ func None[T any]() Option[T] {
 	return Option[T]{value: nil}
 }
 
+// When returns Some(v) if cond is true, otherwise None.
+func When[T any](cond bool, v T) Option[T] {
+	if !cond {
+		return None[T]()
+	}
+	return Some(v)
+}
+
+// WhenElse returns Some(f()) if cond is true, otherwise None. f is only
+// called when cond is true.
+func WhenElse[T any](cond bool, f func() T) Option[T] {
+	if !cond {
+		return None[T]()
+	}
+	return Some(f())
+}
+
+// Unless returns None if cond is true, otherwise Some(v). It is the inverse of When.
+func Unless[T any](cond bool, v T) Option[T] {
+	return When(!cond, v)
+}
+
+// NoneWith creates an Option without a value that records why it is absent.
+// The reason is only kept by the Option itself: combinators such as Map,
+// Filter and Or return a plain None, as do Reset and Set.
+func NoneWith[T any](reason error) Option[T] {
+	if reason == nil {
+		return None[T]()
+	}
+	return Option[T]{reason: &noneReason{err: reason}}
+}
+
+// Reason returns the error passed to NoneWith, or None if the Option is
+// Some or has no recorded reason.
+func (o Option[T]) Reason() Option[error] {
+	if o.value != nil || o.reason == nil {
+		return None[error]()
+	}
+	return Some(o.reason.err)
+}
+
 // IsSome returns true if the Option contains a value.
 func (o Option[T]) IsSome() bool {
 	return o.value != nil
@@ -30,10 +91,44 @@ func (o Option[T]) IsNone() bool {
 	return o.value == nil
 }
 
-// Unwrap returns the value or panics if the Option is None.
+// IsZero returns true if the Option is None. It lets encoders that honor
+// the `omitzero` tag option drop absent Options from their output.
+func (o Option[T]) IsZero() bool {
+	return o.value == nil
+}
+
+// Reset sets the Option to None in place, dropping its reference to the
+// previously held value so it can be garbage collected.
+func (o *Option[T]) Reset() {
+	*o = None[T]()
+}
+
+// Set makes the Option Some(v) in place, replacing any previous value.
+// It has a pointer receiver, so it must be called on an addressable Option
+// such as a variable or struct field.
+func (o *Option[T]) Set(v T) {
+	*o = Some(v)
+}
+
+// Set assigns Some(v) to *dst.
+func Set[T any](dst *Option[T], v T) {
+	dst.Set(v)
+}
+
+// SetIf assigns Some(v) to *dst if cond is true, and leaves *dst untouched
+// otherwise.
+func SetIf[T any](dst *Option[T], v T, cond bool) {
+	if cond {
+		dst.Set(v)
+	}
+}
+
+// Unwrap returns the value or panics if the Option is None. The panic value
+// is an error wrapping ErrNone whose message includes the caller's
+// file:line; match it with errors.Is, not ==.
 func (o Option[T]) Unwrap() T {
 	if o.value == nil {
-		panic("called `Unwrap()` on a `None` value")
+		panicNone()
 	}
 	return *o.value
 }
@@ -54,10 +149,60 @@ func (o Option[T]) UnwrapOrElse(f func() T) T {
 	return *o.value
 }
 
+// AddrOr returns the address of the contained value, or def if the Option
+// is None. Writes through the returned pointer change the Option, and every
+// copy of it, since copies share the value. The pointer stays valid after a
+// Reset or Set, but no longer refers to the Option's value.
+func (o *Option[T]) AddrOr(def *T) *T {
+	if o.value == nil {
+		return def
+	}
+	return o.value
+}
+
+// Split returns whether the Option is Some together with its value, or the
+// zero value of T if it is None. It never panics.
+func (o Option[T]) Split() (present bool, value T) {
+	if o.value == nil {
+		return false, value
+	}
+	return true, *o.value
+}
+
+// UnwrapOrZero returns the value or the zero value of T if the Option is None.
+func (o Option[T]) UnwrapOrZero() T {
+	if o.value == nil {
+		var zero T
+		return zero
+	}
+	return *o.value
+}
+
 // Expect returns the value or a custom error message if the Option is None.
 func (o Option[T]) Expect(errMsg string) (T, error) {
 	if o.value == nil {
-		return *new(T), errors.New(errMsg)
+		var zero T
+		return zero, errors.New(errMsg)
+	}
+	return *o.value, nil
+}
+
+// Expectf is like Expect but formats the error message with fmt.Errorf.
+// The message is only formatted if the Option is None.
+func (o Option[T]) Expectf(format string, args ...any) (T, error) {
+	if o.value == nil {
+		var zero T
+		return zero, fmt.Errorf(format, args...)
+	}
+	return *o.value, nil
+}
+
+// GetOrErr returns the value and a nil error, or the zero value and err if
+// the Option is None.
+func (o Option[T]) GetOrErr(err error) (T, error) {
+	if o.value == nil {
+		var zero T
+		return zero, err
 	}
 	return *o.value, nil
 }
@@ -70,6 +215,51 @@ func Map[T, U any](opt Option[T], f func(T) U) Option[U] {
 	return Some(f(*opt.value))
 }
 
+// Lift turns f into a function over Options that applies f with Map.
+func Lift[T, U any](f func(T) U) func(Option[T]) Option[U] {
+	return func(opt Option[T]) Option[U] {
+		return Map(opt, f)
+	}
+}
+
+// LiftOk turns a comma-ok function into a function over Options. The result
+// is None if the input is None or f reports false.
+func LiftOk[T, U any](f func(T) (U, bool)) func(Option[T]) Option[U] {
+	return func(opt Option[T]) Option[U] {
+		if opt.IsNone() {
+			return None[U]()
+		}
+		u, ok := f(*opt.value)
+		if !ok {
+			return None[U]()
+		}
+		return Some(u)
+	}
+}
+
+// AndThenResult calls the fallible f with the contained value (if present).
+// It returns (Some(u), nil) on success, (None, err) if f fails, and
+// (None, nil) without calling f if the Option is None, so a skipped step is
+// distinguishable from a failed one through the Option.
+func AndThenResult[T, U any](opt Option[T], f func(T) (U, error)) (Option[U], error) {
+	if opt.IsNone() {
+		return None[U](), nil
+	}
+	u, err := f(*opt.value)
+	if err != nil {
+		return None[U](), err
+	}
+	return Some(u), nil
+}
+
+// MapSame applies f to the contained value (if present), keeping the type.
+// Go methods can't introduce type parameters, so Map has to be a package
+// function; MapSame covers the common same-type case as a method so it can
+// be chained, e.g. opt.MapSame(f).Filter(g).
+func (o Option[T]) MapSame(f func(T) T) Option[T] {
+	return Map(o, f)
+}
+
 // And returns None if the first Option is None, otherwise it returns the second Option.
 func And[T, U any](opt Option[T], other Option[U]) Option[U] {
 	if opt.IsNone() {
@@ -78,6 +268,24 @@ func And[T, U any](opt Option[T], other Option[U]) Option[U] {
 	return other
 }
 
+// MergeWith returns Some(combine(a, b)) if both Options are Some, the present
+// one if only one is Some, and None otherwise. combine is only called when
+// both are Some. It is the package-level form of Merge.
+func MergeWith[T any](a, b Option[T], combine func(T, T) T) Option[T] {
+	return a.Merge(b, combine)
+}
+
+// ResolveOr returns the value of the first Some among sources, or def if
+// they are all None. Pass sources in precedence order, e.g. flag, env.
+func ResolveOr[T any](def T, sources ...Option[T]) T {
+	for _, src := range sources {
+		if src.IsSome() {
+			return *src.value
+		}
+	}
+	return def
+}
+
 // Or returns the first Option if it's Some, otherwise it returns the second Option.
 func (o Option[T]) Or(opt Option[T]) Option[T] {
 	if o.IsSome() {
@@ -86,6 +294,29 @@ func (o Option[T]) Or(opt Option[T]) Option[T] {
 	return opt
 }
 
+// Merge combines two Options: if both are Some it returns Some(combine(a, b)),
+// if only one is Some it returns that one, otherwise it returns None.
+func (o Option[T]) Merge(other Option[T], combine func(T, T) T) Option[T] {
+	if o.IsSome() && other.IsSome() {
+		return Some(combine(*o.value, *other.value))
+	}
+	return o.Or(other)
+}
+
+// MapNone returns the Option unchanged if it's Some, otherwise it returns Some(f()).
+func (o Option[T]) MapNone(f func() T) Option[T] {
+	if o.IsSome() {
+		return o
+	}
+	return Some(f())
+}
+
+// OrElseValue returns the Option if it's Some, otherwise it returns Some(f()).
+// f is only called on the None path. It is equivalent to MapNone.
+func (o Option[T]) OrElseValue(f func() T) Option[T] {
+	return o.MapNone(f)
+}
+
 // Filter returns the Option if the value satisfies the predicate, otherwise returns None.
 func (o Option[T]) Filter(predicate func(T) bool) Option[T] {
 	if o.IsSome() && predicate(*o.value) {
@@ -95,9 +326,46 @@ func (o Option[T]) Filter(predicate func(T) bool) Option[T] {
 }
 
 // String returns a string representation of the Option.
+// The output is controlled by NoneString and SomeFormat.
 func (o Option[T]) String() string {
 	if o.IsSome() {
-		return fmt.Sprintf("Some(%v)", *o.value)
+		return fmt.Sprintf(SomeFormat, *o.value)
+	}
+	return NoneString
+}
+
+// ForEach calls f with the contained value if the Option is Some.
+func (o Option[T]) ForEach(f func(T)) {
+	if o.IsSome() {
+		f(*o.value)
+	}
+}
+
+// Consume calls f with the contained value and returns true if the Option
+// is Some, and returns false without calling f otherwise.
+func (o Option[T]) Consume(f func(T)) bool {
+	if o.IsNone() {
+		return false
+	}
+	f(*o.value)
+	return true
+}
+
+// Tee writes the String representation followed by a newline to w if the
+// Option is Some, and returns the Option unchanged. Write errors are ignored.
+func (o Option[T]) Tee(w io.Writer) Option[T] {
+	if o.IsSome() {
+		fmt.Fprintln(w, o.String())
+	}
+	return o
+}
+
+// Iter2 returns an iterator that yields (0, value) once if the Option is
+// Some and nothing if it is None.
+func (o Option[T]) Iter2() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		if o.IsSome() {
+			yield(0, *o.value)
+		}
 	}
-	return "None"
 }
@@ -0,0 +1,417 @@
+package option
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+func TestStringCustomFormat(t *testing.T) {
+	NoneString, SomeFormat = "<absent>", "[%v]"
+	defer func() { NoneString, SomeFormat = "None", "Some(%v)" }()
+
+	if got := None[int]().String(); got != "<absent>" {
+		t.Errorf("None: got %q, want %q", got, "<absent>")
+	}
+	if got := Some(1).String(); got != "[1]" {
+		t.Errorf("Some: got %q, want %q", got, "[1]")
+	}
+	if b, _ := None[int]().MarshalJSON(); string(b) != "null" {
+		t.Errorf("MarshalJSON changed with NoneString: got %s", b)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+	tests := []struct {
+		name string
+		a, b Option[int]
+		want Option[int]
+	}{
+		{"both", Some(1), Some(2), Some(3)},
+		{"left", Some(1), None[int](), Some(1)},
+		{"right", None[int](), Some(2), Some(2)},
+		{"neither", None[int](), None[int](), None[int]()},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Merge(tt.b, add); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestIsZeroOmitZero(t *testing.T) {
+	type S struct {
+		A Option[int] `json:"a,omitzero"`
+	}
+	if b, _ := json.Marshal(S{}); string(b) != `{}` {
+		t.Errorf("None: got %s, want {}", b)
+	}
+	if b, _ := json.Marshal(S{Some(0)}); string(b) != `{"a":0}` {
+		t.Errorf("Some: got %s, want {\"a\":0}", b)
+	}
+}
+
+func TestTee(t *testing.T) {
+	var buf bytes.Buffer
+	None[int]().Tee(&buf)
+	if buf.Len() != 0 {
+		t.Errorf("None wrote %q", buf.String())
+	}
+	if got := Some(3).Tee(&buf); !reflect.DeepEqual(got, Some(3)) {
+		t.Errorf("Tee returned %v, want Some(3)", got)
+	}
+	if got := buf.String(); got != "Some(3)\n" {
+		t.Errorf("got %q, want %q", got, "Some(3)\n")
+	}
+}
+
+func TestExpectf(t *testing.T) {
+	if _, err := None[int]().Expectf("missing %s %d", "id", 7); err == nil || err.Error() != "missing id 7" {
+		t.Errorf("got %v, want %q", err, "missing id 7")
+	}
+	if v, err := Some(1).Expectf("missing %s", "id"); v != 1 || err != nil {
+		t.Errorf("got (%v, %v), want (1, nil)", v, err)
+	}
+}
+
+func TestExpectNoAllocOnSome(t *testing.T) {
+	o := Some(1)
+	if n := testing.AllocsPerRun(100, func() { o.Expect("missing") }); n != 0 {
+		t.Errorf("Expect allocated %v times on Some", n)
+	}
+	if n := testing.AllocsPerRun(100, func() { o.Expectf("missing %d", 1) }); n != 0 {
+		t.Errorf("Expectf allocated %v times on Some", n)
+	}
+}
+
+func BenchmarkExpect(b *testing.B) {
+	some, none := Some([64]int{}), None[[64]int]()
+	b.Run("Some", func(b *testing.B) {
+		for b.Loop() {
+			some.Expect("missing")
+		}
+	})
+	b.Run("None", func(b *testing.B) {
+		for b.Loop() {
+			none.Expect("missing")
+		}
+	})
+}
+
+func BenchmarkExpectf(b *testing.B) {
+	some := Some([64]int{})
+	for b.Loop() {
+		some.Expectf("missing %d", 1)
+	}
+}
+
+func TestMapNone(t *testing.T) {
+	got := Some(1).MapNone(func() int {
+		t.Error("f called for Some")
+		return 0
+	})
+	if !reflect.DeepEqual(got, Some(1)) {
+		t.Errorf("Some: got %v, want Some(1)", got)
+	}
+	if got := None[int]().MapNone(func() int { return 2 }); !reflect.DeepEqual(got, Some(2)) {
+		t.Errorf("None: got %v, want Some(2)", got)
+	}
+}
+
+func TestMergeWith(t *testing.T) {
+	calls := 0
+	union := func(a, b map[string]int) map[string]int {
+		calls++
+		out := map[string]int{}
+		for k, v := range a {
+			out[k] = v
+		}
+		for k, v := range b {
+			out[k] = v
+		}
+		return out
+	}
+	some := func(k string) Option[map[string]int] { return Some(map[string]int{k: 1}) }
+	none := None[map[string]int]()
+
+	if got := MergeWith(some("a"), some("b"), union); len(got.Unwrap()) != 2 {
+		t.Errorf("both: got %v, want two keys", got)
+	}
+	if got := MergeWith(some("a"), none, union); len(got.Unwrap()) != 1 {
+		t.Errorf("left: got %v", got)
+	}
+	if got := MergeWith(none, some("b"), union); len(got.Unwrap()) != 1 {
+		t.Errorf("right: got %v", got)
+	}
+	if got := MergeWith(none, none, union); got.IsSome() {
+		t.Errorf("neither: got %v, want None", got)
+	}
+	if calls != 1 {
+		t.Errorf("combine called %d times, want 1", calls)
+	}
+}
+
+func TestNoneWithReason(t *testing.T) {
+	forbidden := errors.New("forbidden")
+	n := NoneWith[int](forbidden)
+	if !n.IsNone() {
+		t.Errorf("NoneWith is not None: %v", n)
+	}
+	if got := n.Reason(); !reflect.DeepEqual(got, Some(forbidden)) {
+		t.Errorf("Reason: got %v, want Some(forbidden)", got)
+	}
+	if got := Some(1).Reason(); got.IsSome() {
+		t.Errorf("Some has reason %v", got)
+	}
+	if got := None[int]().Reason(); got.IsSome() {
+		t.Errorf("None has reason %v", got)
+	}
+	if got := n.Filter(func(int) bool { return true }).Reason(); got.IsSome() {
+		t.Errorf("Filter kept reason %v", got)
+	}
+}
+
+func TestAndThenResult(t *testing.T) {
+	if got, err := AndThenResult(Some("12"), strconv.Atoi); err != nil || !reflect.DeepEqual(got, Some(12)) {
+		t.Errorf("success: got (%v, %v), want (Some(12), nil)", got, err)
+	}
+	if got, err := AndThenResult(Some("x"), strconv.Atoi); err == nil || got.IsSome() {
+		t.Errorf("failure: got (%v, %v), want (None, error)", got, err)
+	}
+	got, err := AndThenResult(None[string](), func(string) (int, error) {
+		t.Error("f called for None")
+		return 0, nil
+	})
+	if err != nil || got.IsSome() {
+		t.Errorf("skipped: got (%v, %v), want (None, nil)", got, err)
+	}
+}
+
+func TestIter2(t *testing.T) {
+	var pairs [][2]int
+	for i, v := range Some(5).Iter2() {
+		pairs = append(pairs, [2]int{i, v})
+	}
+	if len(pairs) != 1 || pairs[0] != [2]int{0, 5} {
+		t.Errorf("Some: got %v, want [[0 5]]", pairs)
+	}
+	for i, v := range None[int]().Iter2() {
+		t.Errorf("None yielded (%d, %d)", i, v)
+	}
+	for range Some(1).Iter2() {
+		break
+	}
+}
+
+func TestReset(t *testing.T) {
+	o := Some(1)
+	o.Reset()
+	if !o.IsNone() {
+		t.Errorf("after Reset: got %v, want None", o)
+	}
+	o = Some(2)
+	if !reflect.DeepEqual(o, Some(2)) {
+		t.Errorf("after re-setting: got %v, want Some(2)", o)
+	}
+}
+
+func TestResetReleasesValue(t *testing.T) {
+	o := Some([1 << 16]byte{1})
+	o.Reset()
+	if !o.IsNone() || o.value != nil {
+		t.Error("Reset kept a reference to the previous value")
+	}
+}
+
+func TestSetMethod(t *testing.T) {
+	var o Option[int]
+	o.Set(1)
+	o.Set(2)
+	if !reflect.DeepEqual(o, Some(2)) {
+		t.Errorf("got %v, want Some(2)", o)
+	}
+}
+
+func TestForEach(t *testing.T) {
+	calls := 0
+	Some(2).ForEach(func(v int) { calls += v })
+	None[int]().ForEach(func(int) { t.Error("f called for None") })
+	if calls != 2 {
+		t.Errorf("f fired with total %d, want 2", calls)
+	}
+}
+
+func TestSetIf(t *testing.T) {
+	var user struct {
+		Email, Name Option[string]
+	}
+	SetIf(&user.Email, "", false)
+	SetIf(&user.Name, "ada", true)
+	if user.Email.IsSome() {
+		t.Errorf("cond false: got %v, want None", user.Email)
+	}
+	if !reflect.DeepEqual(user.Name, Some("ada")) {
+		t.Errorf("cond true: got %v, want Some(ada)", user.Name)
+	}
+	Set(&user.Email, "a@b")
+	if !reflect.DeepEqual(user.Email, Some("a@b")) {
+		t.Errorf("Set: got %v, want Some(a@b)", user.Email)
+	}
+}
+
+func TestOrElseValue(t *testing.T) {
+	got := Some(1).OrElseValue(func() int {
+		t.Error("f called for Some")
+		return 0
+	})
+	if !reflect.DeepEqual(got, Some(1)) {
+		t.Errorf("Some: got %v, want Some(1)", got)
+	}
+	if got := None[int]().OrElseValue(func() int { return 2 }); !reflect.DeepEqual(got, Some(2)) {
+		t.Errorf("None: got %v, want Some(2)", got)
+	}
+}
+
+func TestAddrOr(t *testing.T) {
+	o := Some(1)
+	*o.AddrOr(nil) = 5
+	if !reflect.DeepEqual(o, Some(5)) {
+		t.Errorf("write through pointer: got %v, want Some(5)", o)
+	}
+	def := 9
+	n := None[int]()
+	if got := n.AddrOr(&def); got != &def {
+		t.Errorf("None: got %p, want %p", got, &def)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	if present, v := Some(3).Split(); !present || v != 3 {
+		t.Errorf("Some: got (%v, %v), want (true, 3)", present, v)
+	}
+	if present, v := None[int]().Split(); present || v != 0 {
+		t.Errorf("None: got (%v, %v), want (false, 0)", present, v)
+	}
+}
+
+func TestConsume(t *testing.T) {
+	total := 0
+	if !Some(1).Consume(func(v int) { total += v }) || total != 1 {
+		t.Errorf("Some: not consumed, total %d", total)
+	}
+	if None[int]().Consume(func(int) { t.Error("f called for None") }) {
+		t.Error("None reported as consumed")
+	}
+}
+
+func TestGetOrErr(t *testing.T) {
+	missing := errors.New("missing")
+	if v, err := Some(1).GetOrErr(missing); v != 1 || err != nil {
+		t.Errorf("Some: got (%v, %v), want (1, nil)", v, err)
+	}
+	if v, err := None[int]().GetOrErr(missing); v != 0 || err != missing {
+		t.Errorf("None: got (%v, %v), want (0, missing)", v, err)
+	}
+}
+
+func TestLift(t *testing.T) {
+	upper := Lift(strings.ToUpper)
+	if got := upper(Some("a")); !reflect.DeepEqual(got, Map(Some("a"), strings.ToUpper)) {
+		t.Errorf("Some: got %v, want Some(A)", got)
+	}
+	if got := upper(None[string]()); got.IsSome() {
+		t.Errorf("None: got %v, want None", got)
+	}
+}
+
+func TestLiftOk(t *testing.T) {
+	m := map[string]int{"a": 1}
+	lookup := LiftOk(func(k string) (int, bool) {
+		v, ok := m[k]
+		return v, ok
+	})
+	if got := lookup(Some("a")); !reflect.DeepEqual(got, Some(1)) {
+		t.Errorf("ok: got %v, want Some(1)", got)
+	}
+	if got := lookup(Some("b")); got.IsSome() {
+		t.Errorf("false: got %v, want None", got)
+	}
+	if got := lookup(None[string]()); got.IsSome() {
+		t.Errorf("None: got %v, want None", got)
+	}
+}
+
+func TestResolveOr(t *testing.T) {
+	cases := []struct {
+		name    string
+		sources []Option[string]
+		want    string
+	}{
+		{"first wins", []Option[string]{Some("flag"), Some("env")}, "flag"},
+		{"last wins", []Option[string]{None[string](), None[string](), Some("env")}, "env"},
+		{"all none", []Option[string]{None[string](), None[string]()}, "default"},
+		{"no sources", nil, "default"},
+	}
+	for _, c := range cases {
+		if got := ResolveOr("default", c.sources...); got != c.want {
+			t.Errorf("%s: got %q, want %q", c.name, got, c.want)
+		}
+	}
+}
+
+func TestWhen(t *testing.T) {
+	if got := When(true, 1); !Equal(got, Some(1)) {
+		t.Errorf("When(true, 1): got %v, want Some(1)", got)
+	}
+	if got := When(false, 1); got.IsSome() {
+		t.Errorf("When(false, 1): got %v, want None", got)
+	}
+	calls := 0
+	f := func() int { calls++; return 2 }
+	if got := WhenElse(true, f); !Equal(got, Some(2)) {
+		t.Errorf("WhenElse(true, f): got %v, want Some(2)", got)
+	}
+	if got := WhenElse(false, f); got.IsSome() {
+		t.Errorf("WhenElse(false, f): got %v, want None", got)
+	}
+	if calls != 1 {
+		t.Errorf("f called %d times, want 1", calls)
+	}
+}
+
+func TestUnless(t *testing.T) {
+	if got := Unless(false, "x"); !Equal(got, Some("x")) {
+		t.Errorf("Unless(false, x): got %v, want Some(x)", got)
+	}
+	if got := Unless(true, "x"); got.IsSome() {
+		t.Errorf("Unless(true, x): got %v, want None", got)
+	}
+}
+
+type sliceErr []string
+
+func (e sliceErr) Error() string { return "slice error" }
+
+func TestNoneWithUncomparableReason(t *testing.T) {
+	n := NoneWith[int](sliceErr{"a"})
+	counts := map[Option[int]]int{n: 1}
+	if counts[n] != 1 {
+		t.Errorf("lookup by the same NoneWith failed: %v", counts)
+	}
+	if n == None[int]() {
+		t.Error("NoneWith compares equal to None under ==")
+	}
+	if got := NoneWith[int](nil); got != None[int]() {
+		t.Errorf("NoneWith(nil) = %v with reason %v, want plain None", got, got.Reason())
+	}
+	var p *int
+	if got, want := unsafe.Sizeof(n), 2*unsafe.Sizeof(p); got != want {
+		t.Errorf("Option is %d bytes, want %d", got, want)
+	}
+}
@@ -0,0 +1,13 @@
+module github.com/mexirica/option-type/optioncmp
+
+go 1.24.0
+
+require (
+	github.com/google/go-cmp v0.7.0
+	github.com/mexirica/option-type v0.1.0
+)
+
+require (
+	github.com/vmihailenco/msgpack/v5 v5.4.1 // indirect
+	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
+)
@@ -0,0 +1,24 @@
+// Package optioncmp provides go-cmp support for option.Option. It is a
+// separate module so that go-cmp is only a dependency of code that uses it.
+package optioncmp
+
+import (
+	"github.com/google/go-cmp/cmp"
+	option "github.com/mexirica/option-type"
+)
+
+// view is the comparable form Transformer converts an Option into.
+type view[T any] struct {
+	Value   T
+	Present bool
+}
+
+// Transformer returns a go-cmp option that compares option.Option[T] values
+// by their contents, so cmp.Equal and cmp.Diff work on them and print
+// readable diffs instead of panicking on the unexported fields.
+func Transformer[T any]() cmp.Option {
+	return cmp.Transformer("Option", func(o option.Option[T]) view[T] {
+		present, value := o.Split()
+		return view[T]{Value: value, Present: present}
+	})
+}
@@ -0,0 +1,33 @@
+package optioncmp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	option "github.com/mexirica/option-type"
+)
+
+func TestTransformerDiff(t *testing.T) {
+	type record struct {
+		A option.Option[int]
+	}
+	if diff := cmp.Diff(record{option.Some(1)}, record{option.Some(1)}, Transformer[int]()); diff != "" {
+		t.Errorf("equal Options reported a diff:\n%s", diff)
+	}
+	diff := cmp.Diff(record{option.Some(1)}, record{option.Some(2)}, Transformer[int]())
+	// go-cmp randomizes the spacing of its output, so compare lines with
+	// whitespace collapsed.
+	lines := make(map[string]bool)
+	for _, line := range strings.Split(diff, "\n") {
+		lines[strings.Join(strings.Fields(line), " ")] = true
+	}
+	for _, want := range []string{"- Value: 1,", "+ Value: 2,"} {
+		if !lines[want] {
+			t.Errorf("diff lacks line %q:\n%s", want, diff)
+		}
+	}
+	if diff := cmp.Diff(record{option.Some(1)}, record{option.None[int]()}, Transformer[int]()); !strings.Contains(diff, "Present") {
+		t.Errorf("Some vs None diff does not mention presence:\n%s", diff)
+	}
+}
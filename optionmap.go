@@ -0,0 +1,40 @@
+package option
+
+// OptionMap is a map whose lookups return Options, distinguishing a stored
+// zero value from a missing key. The zero value is ready to use. Like a
+// built-in map, it is not safe for concurrent use.
+type OptionMap[K comparable, V any] struct {
+	m map[K]V
+}
+
+// Get returns Some with the value stored for key, or None if it is missing.
+func (m *OptionMap[K, V]) Get(key K) Option[V] {
+	v, ok := m.m[key]
+	if !ok {
+		return None[V]()
+	}
+	return Some(v)
+}
+
+// Set stores v for key.
+func (m *OptionMap[K, V]) Set(key K, v V) {
+	if m.m == nil {
+		m.m = make(map[K]V)
+	}
+	m.m[key] = v
+}
+
+// Delete removes key from the map.
+func (m *OptionMap[K, V]) Delete(key K) {
+	delete(m.m, key)
+}
+
+// GetOrInsert returns the value stored for key, storing and returning v if
+// the key is missing.
+func (m *OptionMap[K, V]) GetOrInsert(key K, v V) V {
+	if existing, ok := m.m[key]; ok {
+		return existing
+	}
+	m.Set(key, v)
+	return v
+}
@@ -0,0 +1,29 @@
+package option
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOptionMap(t *testing.T) {
+	var m OptionMap[int, int]
+	if got := m.Get(1); got.IsSome() {
+		t.Errorf("missing key: got %v, want None", got)
+	}
+
+	m.Set(1, 0)
+	if got := m.Get(1); !reflect.DeepEqual(got, Some(0)) {
+		t.Errorf("present zero: got %v, want Some(0)", got)
+	}
+	if got := m.GetOrInsert(1, 5); got != 0 {
+		t.Errorf("GetOrInsert existing: got %d, want 0", got)
+	}
+	if got := m.GetOrInsert(2, 5); got != 5 || !reflect.DeepEqual(m.Get(2), Some(5)) {
+		t.Errorf("GetOrInsert missing: got %d, stored %v", got, m.Get(2))
+	}
+
+	m.Delete(1)
+	if got := m.Get(1); got.IsSome() {
+		t.Errorf("after delete: got %v, want None", got)
+	}
+}
@@ -0,0 +1,13 @@
+package option
+
+// Page is a pagination envelope with an optional cursor for the next page.
+// A None cursor is left out of the JSON encoding.
+type Page[T any] struct {
+	Items []T            `json:"items"`
+	Next  Option[string] `json:"next,omitzero"`
+}
+
+// HasNext reports whether there is a next page.
+func (p Page[T]) HasNext() bool {
+	return p.Next.IsSome()
+}
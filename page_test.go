@@ -0,0 +1,32 @@
+package option
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPageMarshal(t *testing.T) {
+	cases := []struct {
+		page Page[int]
+		want string
+	}{
+		{Page[int]{Items: []int{1, 2}, Next: Some("abc")}, `{"items":[1,2],"next":"abc"}`},
+		{Page[int]{Items: []int{1, 2}}, `{"items":[1,2]}`},
+	}
+	for _, c := range cases {
+		b, err := json.Marshal(c.page)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != c.want {
+			t.Errorf("got %s, want %s", b, c.want)
+		}
+		var back Page[int]
+		if err := json.Unmarshal(b, &back); err != nil {
+			t.Fatal(err)
+		}
+		if back.HasNext() != c.page.HasNext() || !Equal(back.Next, c.page.Next) {
+			t.Errorf("round trip of %s: Next = %v", b, back.Next)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package option
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// MatchGroup returns Some of the text captured by group in the leftmost
+// match of re in s. It returns None if re doesn't match, group is out of
+// range, or the group did not participate in the match.
+func MatchGroup(re *regexp.Regexp, s string, group int) Option[string] {
+	if group < 0 || group > re.NumSubexp() {
+		return None[string]()
+	}
+	loc := re.FindStringSubmatchIndex(s)
+	if loc == nil || loc[2*group] < 0 {
+		return None[string]()
+	}
+	return Some(s[loc[2*group]:loc[2*group+1]])
+}
+
+// MatchNamed is like MatchGroup but selects the group by name.
+func MatchNamed(re *regexp.Regexp, s string, name string) Option[string] {
+	i := re.SubexpIndex(name)
+	if i < 0 {
+		return None[string]()
+	}
+	return MatchGroup(re, s, i)
+}
+
+// ParseTime parses value with time.Parse, returning None if value is empty
+// or fails to parse. The parse error is deliberately discarded.
+func ParseTime(layout, value string) Option[time.Time] {
+	if value == "" {
+		return None[time.Time]()
+	}
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return None[time.Time]()
+	}
+	return Some(t)
+}
+
+// ParseDuration parses s with time.ParseDuration, returning None if s is
+// empty or fails to parse.
+func ParseDuration(s string) Option[time.Duration] {
+	if s == "" {
+		return None[time.Duration]()
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return None[time.Duration]()
+	}
+	return Some(d)
+}
+
+// TrimmedSome returns Some of s with surrounding whitespace removed, or None
+// if nothing is left after trimming.
+func TrimmedSome(s string) Option[string] {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return None[string]()
+	}
+	return Some(s)
+}
+
+// ScanLines reads r line by line and returns the values of the lines parse
+// turns into Some, skipping lines it returns None for. If reading fails,
+// the values collected so far are returned along with the error.
+func ScanLines[T any](r io.Reader, parse func(string) Option[T]) ([]T, error) {
+	var values []T
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if v := parse(scanner.Text()); v.IsSome() {
+			values = append(values, *v.value)
+		}
+	}
+	return values, scanner.Err()
+}
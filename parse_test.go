@@ -0,0 +1,90 @@
+package option
+
+import (
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMatchGroup(t *testing.T) {
+	re := regexp.MustCompile(`(?P<key>\w+)=(?P<value>\d+)(x)?`)
+	if got := MatchGroup(re, "nope", 1); got.IsSome() {
+		t.Errorf("no match: got %v, want None", got)
+	}
+	if got := MatchGroup(re, "a=1", 9); got.IsSome() {
+		t.Errorf("out of range: got %v, want None", got)
+	}
+	if got := MatchGroup(re, "a=1", 3); got.IsSome() {
+		t.Errorf("non-participating group: got %v, want None", got)
+	}
+	if got := MatchGroup(re, "a=1", 1); !reflect.DeepEqual(got, Some("a")) {
+		t.Errorf("group 1: got %v, want Some(a)", got)
+	}
+}
+
+func TestMatchNamed(t *testing.T) {
+	re := regexp.MustCompile(`(?P<key>\w+)=(?P<value>\d+)`)
+	if got := MatchNamed(re, "a=12", "value"); !reflect.DeepEqual(got, Some("12")) {
+		t.Errorf("named: got %v, want Some(12)", got)
+	}
+	if got := MatchNamed(re, "a=12", "missing"); got.IsSome() {
+		t.Errorf("unknown name: got %v, want None", got)
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	if got := ParseTime(time.DateOnly, "2024-01-02"); got.IsNone() || got.Unwrap().Day() != 2 {
+		t.Errorf("valid: got %v, want Some(2024-01-02)", got)
+	}
+	if got := ParseTime(time.DateOnly, "not a date"); got.IsSome() {
+		t.Errorf("invalid: got %v, want None", got)
+	}
+	if got := ParseTime(time.DateOnly, ""); got.IsSome() {
+		t.Errorf("empty: got %v, want None", got)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	if got := ParseDuration("2s"); !reflect.DeepEqual(got, Some(2*time.Second)) {
+		t.Errorf("valid: got %v, want Some(2s)", got)
+	}
+	if got := ParseDuration("soon"); got.IsSome() {
+		t.Errorf("invalid: got %v, want None", got)
+	}
+	if got := ParseDuration(""); got.IsSome() {
+		t.Errorf("empty: got %v, want None", got)
+	}
+}
+
+func TestTrimmedSome(t *testing.T) {
+	if got := TrimmedSome(""); got.IsSome() {
+		t.Errorf("empty: got %v, want None", got)
+	}
+	if got := TrimmedSome(" \t\n"); got.IsSome() {
+		t.Errorf("whitespace: got %v, want None", got)
+	}
+	if got := TrimmedSome("  hi "); !reflect.DeepEqual(got, Some("hi")) {
+		t.Errorf("padded: got %v, want Some(hi)", got)
+	}
+}
+
+func TestScanLines(t *testing.T) {
+	input := "# header\nalpha\n\n  # indented comment\nbeta\n"
+	parse := func(line string) Option[string] {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			return None[string]()
+		}
+		return Some(line)
+	}
+	got, err := ScanLines(strings.NewReader(input), parse)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"alpha", "beta"}; !slices.Equal(got, want) {
+		t.Errorf("ScanLines = %v, want %v", got, want)
+	}
+}
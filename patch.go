@@ -0,0 +1,45 @@
+package option
+
+// Patch is a three-state JSON field for merge-patch semantics: absent (the
+// field was missing), null (the field was explicitly null) or a present value.
+// The zero value is absent.
+type Patch[T any] struct {
+	set   bool
+	value Option[T]
+}
+
+// IsAbsent returns true if the field was missing from the input.
+func (p Patch[T]) IsAbsent() bool {
+	return !p.set
+}
+
+// IsNull returns true if the field was present and explicitly null.
+func (p Patch[T]) IsNull() bool {
+	return p.set && p.value.IsNone()
+}
+
+// Value returns the decoded value, which is None when the field was absent or null.
+func (p Patch[T]) Value() Option[T] {
+	return p.value
+}
+
+// IsZero returns true if the field is absent, so `omitzero` drops it on encode.
+func (p Patch[T]) IsZero() bool {
+	return !p.set
+}
+
+// MarshalJSON encodes a null or absent Patch as null and a present one as its value.
+func (p Patch[T]) MarshalJSON() ([]byte, error) {
+	return p.value.MarshalJSON()
+}
+
+// UnmarshalJSON marks the field as set and decodes null as None.
+// It is only called for fields present in the input, which is how a
+// missing field stays absent.
+func (p *Patch[T]) UnmarshalJSON(data []byte) error {
+	if err := p.value.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	p.set = true
+	return nil
+}
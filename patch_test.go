@@ -0,0 +1,25 @@
+package option
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestPatchUnmarshalStates(t *testing.T) {
+	var s struct {
+		Absent, Null, Value Patch[int]
+	}
+	if err := json.Unmarshal([]byte(`{"Null":null,"Value":5}`), &s); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !s.Absent.IsAbsent() || s.Absent.IsNull() || s.Absent.Value().IsSome() {
+		t.Errorf("absent field: got %+v", s.Absent)
+	}
+	if s.Null.IsAbsent() || !s.Null.IsNull() || s.Null.Value().IsSome() {
+		t.Errorf("null field: got %+v", s.Null)
+	}
+	if s.Value.IsAbsent() || s.Value.IsNull() || !reflect.DeepEqual(s.Value.Value(), Some(5)) {
+		t.Errorf("value field: got %+v", s.Value)
+	}
+}
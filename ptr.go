@@ -0,0 +1,46 @@
+package option
+
+// FromProtoPtr converts an optional protobuf scalar to an Option. It returns
+// None for nil and Some of a copy of *p otherwise.
+func FromProtoPtr[T any](p *T) Option[T] {
+	if p == nil {
+		return None[T]()
+	}
+	return Some(*p)
+}
+
+// ToProtoPtr converts o to an optional protobuf scalar. It returns nil for
+// None and a pointer to a copy of the value for Some, so writes through the
+// pointer don't reach the Option.
+func ToProtoPtr[T any](o Option[T]) *T {
+	return o.Ptr()
+}
+
+// Ptr returns a pointer to a copy of the value, or nil if the Option is None.
+// Writes through the pointer don't affect the Option.
+func (o Option[T]) Ptr() *T {
+	if o.IsNone() {
+		return nil
+	}
+	v := *o.value
+	return &v
+}
+
+// PtrShared returns the Option's internal pointer, or nil if it is None.
+// It avoids copying large values on read-only paths, but the pointer is
+// shared with the Option and every copy of it: callers must not write
+// through it. Use Ptr when in doubt.
+func (o Option[T]) PtrShared() *T {
+	return o.value
+}
+
+// IsNil is an alias for IsNone, for code migrating from *T where absence
+// was checked with p == nil.
+func (o Option[T]) IsNil() bool {
+	return o.IsNone()
+}
+
+// Deref is an alias for UnwrapOrZero, for code migrating from *T.
+func (o Option[T]) Deref() T {
+	return o.UnwrapOrZero()
+}
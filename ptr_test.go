@@ -0,0 +1,62 @@
+package option
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestProtoPtr(t *testing.T) {
+	if got := FromProtoPtr[int32](nil); got.IsSome() {
+		t.Errorf("nil: got %v, want None", got)
+	}
+	if got := ToProtoPtr(None[int32]()); got != nil {
+		t.Errorf("None: got %v, want nil", *got)
+	}
+
+	x := int32(4)
+	o := FromProtoPtr(&x)
+	x = 5
+	p := ToProtoPtr(o)
+	*p = 9
+	if !reflect.DeepEqual(o, Some[int32](4)) {
+		t.Errorf("Option changed through the proto pointers: got %v, want Some(4)", o)
+	}
+}
+
+func TestPointerAliases(t *testing.T) {
+	for _, o := range []Option[int]{Some(5), None[int]()} {
+		if o.IsNil() != o.IsNone() {
+			t.Errorf("%v: IsNil = %v, IsNone = %v", o, o.IsNil(), o.IsNone())
+		}
+		if o.Deref() != o.UnwrapOrZero() {
+			t.Errorf("%v: Deref = %v, UnwrapOrZero = %v", o, o.Deref(), o.UnwrapOrZero())
+		}
+	}
+	if got := None[string]().UnwrapOrZero(); got != "" {
+		t.Errorf("None.UnwrapOrZero() = %q, want empty", got)
+	}
+	if got := Some(5).UnwrapOrZero(); got != 5 {
+		t.Errorf("Some(5).UnwrapOrZero() = %v, want 5", got)
+	}
+}
+
+func TestPtrShared(t *testing.T) {
+	o := Some(10)
+	if p1, p2 := o.PtrShared(), o.PtrShared(); p1 != p2 {
+		t.Errorf("PtrShared returned different addresses %p and %p", p1, p2)
+	}
+	if p := None[int]().PtrShared(); p != nil {
+		t.Errorf("None.PtrShared() = %p, want nil", p)
+	}
+	p := o.Ptr()
+	if p == o.PtrShared() {
+		t.Error("Ptr returned the internal pointer, want a copy")
+	}
+	*p = 20
+	if got := o.Unwrap(); got != 10 {
+		t.Errorf("write through Ptr changed the Option to %v", got)
+	}
+	if p := None[int]().Ptr(); p != nil {
+		t.Errorf("None.Ptr() = %p, want nil", p)
+	}
+}
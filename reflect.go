@@ -0,0 +1,202 @@
+package option
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// anyOption is implemented by every Option[T], letting reflection code
+// detect Options and report their presence without knowing T.
+type anyOption interface {
+	isSome() bool
+	isNone() bool
+	anyValue() any
+}
+
+func (o Option[T]) isSome() bool {
+	return o.value != nil
+}
+
+func (o Option[T]) isNone() bool {
+	return o.value == nil
+}
+
+// anyValue returns the contained value, or nil if the Option is None.
+func (o Option[T]) anyValue() any {
+	if o.value == nil {
+		return nil
+	}
+	return *o.value
+}
+
+// IsOption reports whether v is an Option of any type. A pointer to an
+// Option is not itself an Option.
+func IsOption(v any) bool {
+	_, ok := toAnyOption(v)
+	return ok
+}
+
+// OptionIsSome reports whether v is Some. The second result is false if v is
+// not an Option at all, including when it is a pointer to one.
+func OptionIsSome(v any) (isSome bool, ok bool) {
+	opt, ok := toAnyOption(v)
+	if !ok {
+		return false, false
+	}
+	return opt.isSome(), true
+}
+
+// toAnyOption returns v as an anyOption if it is an Option value. *Option[T]
+// also has isSome in its method set, but calling it through a nil pointer
+// panics, so pointers are rejected.
+func toAnyOption(v any) (anyOption, bool) {
+	opt, ok := v.(anyOption)
+	if !ok || reflect.TypeOf(v).Kind() == reflect.Pointer {
+		return nil, false
+	}
+	return opt, true
+}
+
+// defaultSetter is implemented by *Option[T] so reflection code can fill in
+// a default without knowing T.
+type defaultSetter interface {
+	IsSome() bool
+	setDefault(s string) error
+}
+
+func (o *Option[T]) setDefault(s string) error {
+	var v T
+	if err := parseInto(reflect.ValueOf(&v).Elem(), s); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+// ApplyDefaults sets every exported Option field of the struct pointed to by
+// structPtr that is None and has a `default` tag to Some of the parsed tag
+// value. Strings, bools, integers, floats and time.Duration are supported.
+func ApplyDefaults(structPtr any) error {
+	rv := reflect.ValueOf(structPtr)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("option: ApplyDefaults requires a non-nil pointer to a struct")
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		tag, ok := field.Tag.Lookup("default")
+		if !ok || !field.IsExported() {
+			continue
+		}
+		ds, ok := rv.Field(i).Addr().Interface().(defaultSetter)
+		if !ok || ds.IsSome() {
+			continue
+		}
+		if err := ds.setDefault(tag); err != nil {
+			return fmt.Errorf("option: default for field %s: %w", field.Name, err)
+		}
+	}
+	return nil
+}
+
+// parseInto parses s into v according to v's kind.
+func parseInto(v reflect.Value, s string) error {
+	if v.Type() == reflect.TypeFor[time.Duration]() {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+// PresenceReport walks the exported fields of the struct v (or the struct v
+// points to) and reports, for each Option field, whether it is Some.
+// Fields of type *Option[T] are reported through the pointer, and left out
+// when it is nil. Non-Option fields are left out. It returns nil if v is not
+// a struct.
+func PresenceReport(v any) map[string]bool {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+	rt := rv.Type()
+	report := make(map[string]bool)
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fv := rv.Field(i)
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+		if opt, ok := fv.Interface().(anyOption); ok {
+			report[field.Name] = opt.isSome()
+		}
+	}
+	return report
+}
+
+// CastAny returns Some(v.(T)) if v holds a T, otherwise None.
+func CastAny[T any](v any) Option[T] {
+	t, ok := v.(T)
+	if !ok {
+		return None[T]()
+	}
+	return Some(t)
+}
+
+// FirstAssignable returns the first of targets that the dynamic type of v is
+// assignable to, or None if there is none or v is nil.
+func FirstAssignable(v any, targets ...reflect.Type) Option[reflect.Type] {
+	if v == nil {
+		return None[reflect.Type]()
+	}
+	vt := reflect.TypeOf(v)
+	for _, t := range targets {
+		if vt.AssignableTo(t) {
+			return Some(t)
+		}
+	}
+	return None[reflect.Type]()
+}
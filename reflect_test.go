@@ -0,0 +1,124 @@
+package option
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestApplyDefaults(t *testing.T) {
+	var cfg struct {
+		Port    Option[int]           `default:"8080"`
+		Host    Option[string]        `default:"localhost"`
+		Debug   Option[bool]          `default:"true"`
+		Timeout Option[time.Duration] `default:"5s"`
+		Name    Option[string]        `default:"fallback"`
+		NoTag   Option[int]
+	}
+	if err := json.Unmarshal([]byte(`{"Name":"set"}`), &cfg); err != nil {
+		t.Fatalf("unexpected decode error: %v", err)
+	}
+	if err := ApplyDefaults(&cfg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(cfg.Port, Some(8080)) || !reflect.DeepEqual(cfg.Host, Some("localhost")) ||
+		!reflect.DeepEqual(cfg.Debug, Some(true)) || !reflect.DeepEqual(cfg.Timeout, Some(5*time.Second)) {
+		t.Errorf("defaults not applied: %+v", cfg)
+	}
+	if !reflect.DeepEqual(cfg.Name, Some("set")) {
+		t.Errorf("decoded value overwritten: got %v", cfg.Name)
+	}
+	if cfg.NoTag.IsSome() {
+		t.Errorf("untagged field set: got %v", cfg.NoTag)
+	}
+}
+
+func TestApplyDefaultsErrors(t *testing.T) {
+	var bad struct {
+		Small Option[int8] `default:"999"`
+	}
+	if err := ApplyDefaults(&bad); err == nil {
+		t.Error("out-of-range default: expected error")
+	}
+	if err := ApplyDefaults(bad); err == nil {
+		t.Error("non-pointer: expected error")
+	}
+}
+
+func TestPresenceReport(t *testing.T) {
+	type record struct {
+		A      Option[int]
+		B      Option[string]
+		Plain  int
+		hidden Option[int]
+	}
+	r := record{A: Some(1), hidden: Some(2)}
+	want := map[string]bool{"A": true, "B": false}
+	if got := PresenceReport(r); !maps.Equal(got, want) {
+		t.Errorf("value: got %v, want %v", got, want)
+	}
+	if got := PresenceReport(&r); !maps.Equal(got, want) {
+		t.Errorf("pointer: got %v, want %v", got, want)
+	}
+	if got := PresenceReport(3); got != nil {
+		t.Errorf("non-struct: got %v, want nil", got)
+	}
+	set := Some(3)
+	ptrs := struct {
+		Nil *Option[int]
+		Set *Option[int]
+	}{Set: &set}
+	if got, want := PresenceReport(ptrs), map[string]bool{"Set": true}; !maps.Equal(got, want) {
+		t.Errorf("pointer fields: got %v, want %v", got, want)
+	}
+}
+
+func TestIsOption(t *testing.T) {
+	if !IsOption(Some(1)) || !IsOption(None[string]()) {
+		t.Error("Option values not detected")
+	}
+	if IsOption(1) || IsOption(nil) {
+		t.Error("non-Option values detected as Options")
+	}
+	if isSome, ok := OptionIsSome(Some(1)); !isSome || !ok {
+		t.Errorf("Some: got (%v, %v), want (true, true)", isSome, ok)
+	}
+	if isSome, ok := OptionIsSome(None[int]()); isSome || !ok {
+		t.Errorf("None: got (%v, %v), want (false, true)", isSome, ok)
+	}
+	if _, ok := OptionIsSome("x"); ok {
+		t.Error("non-Option: got ok=true")
+	}
+	o := Some(1)
+	if IsOption(&o) {
+		t.Error("pointer to Option detected as an Option")
+	}
+	if isSome, ok := OptionIsSome((*Option[int])(nil)); isSome || ok {
+		t.Errorf("nil pointer: got (%v, %v), want (false, false)", isSome, ok)
+	}
+}
+
+func TestCastAny(t *testing.T) {
+	if got := CastAny[int](1); !reflect.DeepEqual(got, Some(1)) {
+		t.Errorf("matching: got %v, want Some(1)", got)
+	}
+	if got := CastAny[string](1); got.IsSome() {
+		t.Errorf("mismatch: got %v, want None", got)
+	}
+}
+
+func TestFirstAssignable(t *testing.T) {
+	intType, stringer := reflect.TypeFor[int](), reflect.TypeFor[fmt.Stringer]()
+	if got := FirstAssignable(Some(1), intType, stringer); got.IsNone() || got.Unwrap() != stringer {
+		t.Errorf("assignable: got %v, want Some(fmt.Stringer)", got)
+	}
+	if got := FirstAssignable(1, stringer); got.IsSome() {
+		t.Errorf("not assignable: got %v, want None", got)
+	}
+	if got := FirstAssignable(nil, stringer); got.IsSome() {
+		t.Errorf("nil: got %v, want None", got)
+	}
+}
@@ -0,0 +1,50 @@
+package option
+
+import (
+	"context"
+	"time"
+)
+
+// Retry calls f up to attempts times and returns the first Some it yields.
+// It returns None if every attempt yields None or attempts <= 0.
+func Retry[T any](attempts int, f func() Option[T]) Option[T] {
+	for i := 0; i < attempts; i++ {
+		if opt := f(); opt.IsSome() {
+			return opt
+		}
+	}
+	return None[T]()
+}
+
+// RetryCtx calls f up to attempts times and returns the first Some it yields,
+// waiting backoff(n) after the n-th failed attempt. It returns None if the
+// attempts are exhausted or ctx is cancelled, including while waiting.
+func RetryCtx[T any](ctx context.Context, attempts int, backoff func(int) time.Duration, f func(context.Context) Option[T]) Option[T] {
+	for i := 1; i <= attempts; i++ {
+		if ctx.Err() != nil {
+			return None[T]()
+		}
+		if opt := f(ctx); opt.IsSome() {
+			return opt
+		}
+		if i == attempts {
+			break
+		}
+		timer := time.NewTimer(backoff(i))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return None[T]()
+		}
+	}
+	return None[T]()
+}
+
+// RetryWithBackoff is like Retry but sleeps delay(n) after the n-th failed
+// attempt. Use RetryCtx if the wait needs to be cancellable.
+func RetryWithBackoff[T any](attempts int, delay func(int) time.Duration, f func() Option[T]) Option[T] {
+	return RetryCtx(context.Background(), attempts, delay, func(context.Context) Option[T] {
+		return f()
+	})
+}
@@ -0,0 +1,111 @@
+package option
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRetry(t *testing.T) {
+	calls := 0
+	got := Retry(5, func() Option[int] {
+		calls++
+		if calls < 2 {
+			return None[int]()
+		}
+		return Some(calls)
+	})
+	if !reflect.DeepEqual(got, Some(2)) || calls != 2 {
+		t.Errorf("early stop: got %v after %d calls, want Some(2) after 2", got, calls)
+	}
+
+	calls = 0
+	got = Retry(3, func() Option[int] {
+		calls++
+		return None[int]()
+	})
+	if got.IsSome() || calls != 3 {
+		t.Errorf("exhausted: got %v after %d calls, want None after 3", got, calls)
+	}
+
+	got = Retry(0, func() Option[int] {
+		t.Error("f called with attempts <= 0")
+		return Some(1)
+	})
+	if got.IsSome() {
+		t.Errorf("zero attempts: got %v, want None", got)
+	}
+}
+
+func TestRetryCtx(t *testing.T) {
+	short := func(int) time.Duration { return time.Millisecond }
+
+	calls := 0
+	got := RetryCtx(context.Background(), 3, short, func(context.Context) Option[int] {
+		calls++
+		if calls < 2 {
+			return None[int]()
+		}
+		return Some(7)
+	})
+	if !reflect.DeepEqual(got, Some(7)) {
+		t.Errorf("second try: got %v, want Some(7)", got)
+	}
+
+	calls = 0
+	got = RetryCtx(context.Background(), 3, short, func(context.Context) Option[int] {
+		calls++
+		return None[int]()
+	})
+	if got.IsSome() || calls != 3 {
+		t.Errorf("exhausted: got %v after %d calls, want None after 3", got, calls)
+	}
+}
+
+func TestRetryCtxCancelDuringBackoff(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	calls := 0
+	start := time.Now()
+	got := RetryCtx(ctx, 3, func(int) time.Duration { return time.Hour }, func(context.Context) Option[int] {
+		calls++
+		return None[int]()
+	})
+	if got.IsSome() || calls != 1 {
+		t.Errorf("got %v after %d calls, want None after 1", got, calls)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("cancellation did not interrupt the backoff, took %v", elapsed)
+	}
+}
+
+func TestRetryWithBackoff(t *testing.T) {
+	var delays []int
+	delay := func(n int) time.Duration {
+		delays = append(delays, n)
+		return time.Millisecond
+	}
+
+	calls := 0
+	got := RetryWithBackoff(3, delay, func() Option[int] {
+		calls++
+		if calls < 2 {
+			return None[int]()
+		}
+		return Some(1)
+	})
+	if !reflect.DeepEqual(got, Some(1)) || len(delays) != 1 {
+		t.Errorf("second attempt: got %v with delays %v, want Some(1) with one delay", got, delays)
+	}
+
+	delays, calls = nil, 0
+	got = RetryWithBackoff(3, delay, func() Option[int] {
+		calls++
+		return None[int]()
+	})
+	if got.IsSome() || calls != 3 || len(delays) != 2 {
+		t.Errorf("failure: got %v after %d calls and delays %v", got, calls, delays)
+	}
+}
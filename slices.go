@@ -0,0 +1,121 @@
+package option
+
+// FirstUnique returns the first element of s that appears exactly once,
+// or None if every element repeats or s is empty.
+func FirstUnique[T comparable](s []T) Option[T] {
+	counts := make(map[T]int, len(s))
+	for _, v := range s {
+		counts[v]++
+	}
+	for _, v := range s {
+		if counts[v] == 1 {
+			return Some(v)
+		}
+	}
+	return None[T]()
+}
+
+// First returns the first element of s, or None if s is empty.
+func First[T any](s []T) Option[T] {
+	if len(s) == 0 {
+		return None[T]()
+	}
+	return Some(s[0])
+}
+
+// Last returns the last element of s, or None if s is empty.
+func Last[T any](s []T) Option[T] {
+	if len(s) == 0 {
+		return None[T]()
+	}
+	return Some(s[len(s)-1])
+}
+
+// Drain returns the values of the Some elements of opts in order and sets
+// every element of opts to None, so the backing array can be reused.
+func Drain[T any](opts []Option[T]) []T {
+	values := make([]T, 0, len(opts))
+	for i, o := range opts {
+		if o.IsSome() {
+			values = append(values, *o.value)
+		}
+		opts[i] = None[T]()
+	}
+	return values
+}
+
+// FromVariadic returns Some of the first argument, or None if no arguments
+// are passed. Extra arguments are ignored. It is meant for emulating an
+// optional parameter with `func Foo(arg ...T)`.
+func FromVariadic[T any](args ...T) Option[T] {
+	return First(args)
+}
+
+// ToMap indexes s by key. Later elements overwrite earlier ones with the
+// same key.
+func ToMap[K comparable, V any](s []V, key func(V) K) map[K]V {
+	m := make(map[K]V, len(s))
+	for _, v := range s {
+		m[key(v)] = v
+	}
+	return m
+}
+
+// LookupMap returns a function that looks up keys in m, returning None for
+// missing keys.
+func LookupMap[K comparable, V any](m map[K]V) func(K) Option[V] {
+	return func(k K) Option[V] {
+		v, ok := m[k]
+		if !ok {
+			return None[V]()
+		}
+		return Some(v)
+	}
+}
+
+// NonEmptySlice returns None if o is None or holds an empty slice, and o
+// unchanged otherwise.
+func NonEmptySlice[T any](o Option[[]T]) Option[[]T] {
+	return o.Filter(func(s []T) bool { return len(s) > 0 })
+}
+
+// Mode returns the most frequent element of s, or None if s is empty.
+// Ties go to the element that was encountered first.
+func Mode[T comparable](s []T) Option[T] {
+	counts := make(map[T]int, len(s))
+	for _, v := range s {
+		counts[v]++
+	}
+	mode, best := None[T](), 0
+	for _, v := range s {
+		if counts[v] > best {
+			mode, best = Some(v), counts[v]
+		}
+	}
+	return mode
+}
+
+// At returns Some(s[i]), or None if i is out of range. Negative indices
+// count from the end, so At(s, -1) is the last element.
+func At[T any](s []T, i int) Option[T] {
+	if i < 0 {
+		i += len(s)
+	}
+	if i < 0 || i >= len(s) {
+		return None[T]()
+	}
+	return Some(s[i])
+}
+
+// ReduceOption folds s from the left with f, using the first element as the
+// initial accumulator. It returns None if s is empty.
+func ReduceOption[T any](s []T, f func(acc, x T) T) Option[T] {
+	if len(s) == 0 {
+		return None[T]()
+	}
+	acc := s[0]
+	for _, x := range s[1:] {
+		acc = f(acc, x)
+	}
+	return Some(acc)
+}
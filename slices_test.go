@@ -0,0 +1,143 @@
+package option
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestFirstUnique(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want Option[int]
+	}{
+		{"empty", nil, None[int]()},
+		{"all duplicate", []int{1, 1, 2, 2}, None[int]()},
+		{"all unique", []int{3, 4, 5}, Some(3)},
+		{"mixed", []int{1, 2, 1, 3}, Some(2)},
+	}
+	for _, tt := range tests {
+		if got := FirstUnique(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFirstLast(t *testing.T) {
+	tests := []struct {
+		name        string
+		in          []int
+		first, last Option[int]
+	}{
+		{"empty", nil, None[int](), None[int]()},
+		{"single", []int{1}, Some(1), Some(1)},
+		{"multi", []int{1, 2, 3}, Some(1), Some(3)},
+	}
+	for _, tt := range tests {
+		if got := First(tt.in); !reflect.DeepEqual(got, tt.first) {
+			t.Errorf("%s First: got %v, want %v", tt.name, got, tt.first)
+		}
+		if got := Last(tt.in); !reflect.DeepEqual(got, tt.last) {
+			t.Errorf("%s Last: got %v, want %v", tt.name, got, tt.last)
+		}
+	}
+}
+
+func TestDrain(t *testing.T) {
+	opts := []Option[int]{Some(1), None[int](), Some(2)}
+	if got := fmt.Sprint(Drain(opts)); got != "[1 2]" {
+		t.Errorf("got %s, want [1 2]", got)
+	}
+	for i, o := range opts {
+		if o.IsSome() {
+			t.Errorf("element %d still %v after Drain", i, o)
+		}
+	}
+}
+
+func TestFromVariadic(t *testing.T) {
+	if got := FromVariadic[int](); got.IsSome() {
+		t.Errorf("no args: got %v, want None", got)
+	}
+	if got := FromVariadic(1); !reflect.DeepEqual(got, Some(1)) {
+		t.Errorf("one arg: got %v, want Some(1)", got)
+	}
+	if got := FromVariadic(2, 3); !reflect.DeepEqual(got, Some(2)) {
+		t.Errorf("many args: got %v, want Some(2)", got)
+	}
+}
+
+func TestLookupMap(t *testing.T) {
+	m := ToMap([]string{"apple", "banana"}, func(s string) byte { return s[0] })
+	lookup := LookupMap(m)
+	if got := lookup('a'); !reflect.DeepEqual(got, Some("apple")) {
+		t.Errorf("present: got %v, want Some(apple)", got)
+	}
+	if got := lookup('z'); got.IsSome() {
+		t.Errorf("absent: got %v, want None", got)
+	}
+}
+
+func TestNonEmptySlice(t *testing.T) {
+	if got := NonEmptySlice(None[[]int]()); got.IsSome() {
+		t.Errorf("None: got %v, want None", got)
+	}
+	if got := NonEmptySlice(Some([]int{})); got.IsSome() {
+		t.Errorf("empty: got %v, want None", got)
+	}
+	if got := NonEmptySlice(Some([]int{1})); got.IsNone() || got.Unwrap()[0] != 1 {
+		t.Errorf("non-empty: got %v, want Some([1])", got)
+	}
+}
+
+func TestMode(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want Option[int]
+	}{
+		{"empty", nil, None[int]()},
+		{"single mode", []int{1, 2, 2}, Some(2)},
+		{"tie goes to first seen", []int{3, 1, 1, 3}, Some(3)},
+	}
+	for _, tt := range tests {
+		if got := Mode(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestAt(t *testing.T) {
+	s := []int{1, 2, 3}
+	tests := []struct {
+		name string
+		in   []int
+		i    int
+		want Option[int]
+	}{
+		{"in range", s, 1, Some(2)},
+		{"out of range", s, 3, None[int]()},
+		{"negative", s, -1, Some(3)},
+		{"negative out of range", s, -4, None[int]()},
+		{"empty", nil, 0, None[int]()},
+	}
+	for _, tt := range tests {
+		if got := At(tt.in, tt.i); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestReduceOption(t *testing.T) {
+	sub := func(acc, x int) int { return acc - x }
+	if got := ReduceOption[int](nil, sub); got.IsSome() {
+		t.Errorf("empty: got %v, want None", got)
+	}
+	if got := ReduceOption([]int{5}, sub); !reflect.DeepEqual(got, Some(5)) {
+		t.Errorf("single: got %v, want Some(5)", got)
+	}
+	if got := ReduceOption([]int{10, 3, 2}, sub); !reflect.DeepEqual(got, Some(5)) {
+		t.Errorf("multi: got %v, want Some(5)", got)
+	}
+}
@@ -0,0 +1,12 @@
+package option
+
+import "log/slog"
+
+// LogValue implements slog.LogValuer. Some logs as its inner value and None
+// logs as the string "<none>".
+func (o Option[T]) LogValue() slog.Value {
+	if o.IsNone() {
+		return slog.StringValue("<none>")
+	}
+	return slog.AnyValue(*o.value)
+}
@@ -0,0 +1,16 @@
+package option
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+)
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	logger.Info("lookup", "some", Some(42), "none", None[int]())
+	if want := `"some":42,"none":"<none>"`; !bytes.Contains(buf.Bytes(), []byte(want)) {
+		t.Errorf("got %s, want it to contain %s", buf.String(), want)
+	}
+}
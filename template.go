@@ -0,0 +1,52 @@
+package option
+
+import (
+	"fmt"
+	"text/template"
+)
+
+// FuncMap returns template functions for working with Option fields:
+//
+//	isSome .Field          reports whether the Option is Some
+//	isNone .Field          reports whether the Option is None
+//	unwrapOr .Field "n/a"  returns the value, or the default if None
+//
+// Each function returns an error if its first argument is not an Option,
+// including when it is a pointer to one.
+// For html/template, convert the result with html/template.FuncMap.
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"isSome": func(v any) (bool, error) {
+			opt, err := asOption("isSome", v)
+			if err != nil {
+				return false, err
+			}
+			return opt.isSome(), nil
+		},
+		"isNone": func(v any) (bool, error) {
+			opt, err := asOption("isNone", v)
+			if err != nil {
+				return false, err
+			}
+			return opt.isNone(), nil
+		},
+		"unwrapOr": func(v any, def any) (any, error) {
+			opt, err := asOption("unwrapOr", v)
+			if err != nil {
+				return nil, err
+			}
+			if opt.isNone() {
+				return def, nil
+			}
+			return opt.anyValue(), nil
+		},
+	}
+}
+
+func asOption(fn string, v any) (anyOption, error) {
+	opt, ok := toAnyOption(v)
+	if !ok {
+		return nil, fmt.Errorf("option: %s: %T is not an Option", fn, v)
+	}
+	return opt, nil
+}
@@ -0,0 +1,31 @@
+package option
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func TestFuncMap(t *testing.T) {
+	tmpl := template.Must(template.New("t").Funcs(FuncMap()).Parse(
+		`{{if isSome .Name}}name={{unwrapOr .Name "?"}}{{end}};{{if isNone .Nick}}nick={{unwrapOr .Nick "n/a"}}{{end}}`))
+	data := struct {
+		Name Option[string]
+		Nick Option[string]
+	}{Some("ada"), None[string]()}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := b.String(), "name=ada;nick=n/a"; got != want {
+		t.Errorf("rendered %q, want %q", got, want)
+	}
+
+	bad := template.Must(template.New("bad").Funcs(FuncMap()).Parse(`{{isSome .}}`))
+	if err := bad.Execute(&b, 42); err == nil || !strings.Contains(err.Error(), "is not an Option") {
+		t.Errorf("non-Option argument: got error %v", err)
+	}
+	if err := bad.Execute(&b, (*Option[int])(nil)); err == nil || !strings.Contains(err.Error(), "is not an Option") {
+		t.Errorf("nil *Option argument: got error %v", err)
+	}
+}
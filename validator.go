@@ -0,0 +1,29 @@
+package option
+
+// Validator checks a value against a list of predicates.
+type Validator[T any] struct {
+	predicates []func(T) bool
+}
+
+// NewValidator creates a Validator with no predicates.
+func NewValidator[T any]() *Validator[T] {
+	return &Validator[T]{}
+}
+
+// Must adds a predicate the value has to satisfy and returns the Validator
+// for chaining.
+func (v *Validator[T]) Must(predicate func(T) bool) *Validator[T] {
+	v.predicates = append(v.predicates, predicate)
+	return v
+}
+
+// Apply returns Some(value) if every predicate passes, otherwise None.
+// Predicates run in the order they were added and stop at the first failure.
+func (v *Validator[T]) Apply(value T) Option[T] {
+	for _, p := range v.predicates {
+		if !p(value) {
+			return None[T]()
+		}
+	}
+	return Some(value)
+}
@@ -0,0 +1,30 @@
+package option
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidator(t *testing.T) {
+	calls := 0
+	v := NewValidator[string]().
+		Must(func(s string) bool { return s != "" }).
+		Must(func(s string) bool {
+			calls++
+			return strings.Contains(s, "@")
+		})
+
+	if got := v.Apply("a@b"); !reflect.DeepEqual(got, Some("a@b")) {
+		t.Errorf("passing: got %v, want Some(a@b)", got)
+	}
+	if got := v.Apply("ab"); got.IsSome() {
+		t.Errorf("failing: got %v, want None", got)
+	}
+	if got := v.Apply(""); got.IsSome() {
+		t.Errorf("first predicate failing: got %v, want None", got)
+	}
+	if calls != 2 {
+		t.Errorf("second predicate ran %d times, want 2 (short-circuit)", calls)
+	}
+}